@@ -1,15 +1,17 @@
 package client
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"strings"
 )
 
 const (
 	// hubspotBaseURL is the base URL for the HubSpot API
 	hubspotBaseURL = "https://api.hubapi.com/"
+	// formsBaseURL is the base URL for submitting data to HubSpot forms
+	formsBaseURL = "https://api.hsforms.com/"
 )
 
 // Client contains all the functions to communicate between HubSpot and your app.
@@ -17,6 +19,9 @@ type Client struct {
 	// HubSpot's APIs allow for two means of authentication, OAuth and API keys.  API keys are great for rapid prototyping.
 	// You can generate a new API key under the Settings -> Integrations -> API key menu
 	APIKey string
+	// Apps are managed from a developer account, which has its own developer API key. The APIs to manage app settings,
+	// like webhook subscriptions and timeline event templates, use that key instead of the API key of a portal.
+	DeveloperKey string
 }
 
 // NewClient returns a new Client pointer that can be chained with builder
@@ -32,23 +37,55 @@ func (c *Client) WithAPIKey(apikey string) *Client {
 	return c
 }
 
+// WithDeveloperKey sets a config developer API key value returning a Client pointer for
+// chaining.
+func (c *Client) WithDeveloperKey(developerKey string) *Client {
+	c.DeveloperKey = developerKey
+	return c
+}
+
+// DeveloperAPIKey returns the developer API key, or the API key when no developer API key has been set.
+func (c *Client) DeveloperAPIKey() string {
+	if c.DeveloperKey != "" {
+		return c.DeveloperKey
+	}
+	return c.APIKey
+}
+
 // Call sends a request to HubSpot and receives the response.
 func (c *Client) Call(urlSuffix string, httpMethod string, payload []byte) ([]byte, error) {
+	return c.CallWithContentType(urlSuffix, httpMethod, "application/json", payload)
+}
+
+// CallWithContentType sends a request with a payload of the given content type to HubSpot and receives the response.
+// It is used for endpoints that don't accept JSON, like multipart file uploads.
+func (c *Client) CallWithContentType(urlSuffix string, httpMethod string, contentType string, payload []byte) ([]byte, error) {
+	return send(fmt.Sprintf("%s%s", hubspotBaseURL, urlSuffix), httpMethod, contentType, payload)
+}
+
+// CallForms sends a JSON request to the HubSpot forms host, which receives form submissions, and receives the
+// response. The urlSuffix shouldn't contain the API key, as the forms host doesn't need one.
+func (c *Client) CallForms(urlSuffix string, httpMethod string, payload []byte) ([]byte, error) {
+	return send(fmt.Sprintf("%s%s", formsBaseURL, urlSuffix), httpMethod, "application/json", payload)
+}
+
+// send sends a request to the URL and receives the response.
+func send(url string, httpMethod string, contentType string, payload []byte) ([]byte, error) {
 	var req *http.Request
 	var err error
 
 	if len(payload) > 0 {
-		req, err = http.NewRequest(httpMethod, fmt.Sprintf("%s%s", hubspotBaseURL, urlSuffix), strings.NewReader(string(payload)))
+		req, err = http.NewRequest(httpMethod, url, bytes.NewReader(payload))
 	} else {
-		req, err = http.NewRequest(httpMethod, fmt.Sprintf("%s%s", hubspotBaseURL, urlSuffix), nil)
+		req, err = http.NewRequest(httpMethod, url, nil)
 	}
 
-	req.Header["Content-Type"] = []string{"application/json"}
-
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header["Content-Type"] = []string{contentType}
+
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -16,4 +16,8 @@ func TestClient(t *testing.T) {
 
 	hubspot = NewClient().WithAPIKey(apikey)
 	assert.Equal(t, hubspot.APIKey, apikey)
+	assert.Equal(t, hubspot.DeveloperAPIKey(), apikey)
+
+	hubspot = hubspot.WithDeveloperKey("developer")
+	assert.Equal(t, hubspot.DeveloperAPIKey(), "developer")
 }
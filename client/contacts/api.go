@@ -1,7 +1,11 @@
 // Package contacts are for the fundamental building block to HubSpot, contacts. They store lead-specific data that makes it possible to leverage much of the functionality in HubSpot, from marketing automation, to lead scoring to smart content.
 package contacts
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/retgits/hubspot/client"
+)
 
 // HubSpotContacts is the payload returned after calling the Contacts API
 type HubSpotContacts struct {
@@ -13,18 +17,65 @@ type HubSpotContacts struct {
 
 // Contact is a single contact in HubSpot
 type Contact struct {
-	AddedAt          int64                        `json:"addedAt"`
-	Vid              int64                        `json:"vid"`
-	CanonicalVid     int64                        `json:"canonical-vid"`
-	MergedVids       []interface{}                `json:"merged-vids"`
-	PortalID         int64                        `json:"portal-id"`
-	IsContact        bool                         `json:"is-contact"`
-	ProfileToken     string                       `json:"profile-token"`
-	ProfileURL       string                       `json:"profile-url"`
-	Properties       map[string]map[string]string `json:"properties"`
-	FormSubmissions  []interface{}                `json:"form-submissions"`
-	IdentityProfiles []IdentityProfile            `json:"identity-profiles"`
-	MergeAudits      []interface{}                `json:"merge-audits"`
+	AddedAt          int64                           `json:"addedAt"`
+	Vid              int64                           `json:"vid"`
+	CanonicalVid     int64                           `json:"canonical-vid"`
+	MergedVids       []interface{}                   `json:"merged-vids"`
+	PortalID         int64                           `json:"portal-id"`
+	IsContact        bool                            `json:"is-contact"`
+	ProfileToken     string                          `json:"profile-token"`
+	ProfileURL       string                          `json:"profile-url"`
+	Properties       map[string]client.PropertyValue `json:"properties"`
+	FormSubmissions  []FormSubmission                `json:"form-submissions"`
+	IdentityProfiles []IdentityProfile               `json:"identity-profiles"`
+	MergeAudits      []interface{}                   `json:"merge-audits"`
+}
+
+// Email returns the primary email address of the contact from its identity profiles, or the value of the email
+// property when the contact has no email identity.
+func (c *Contact) Email() string {
+	email := ""
+
+	for _, profile := range c.IdentityProfiles {
+		for _, identity := range profile.Identities {
+			if identity.Type != "EMAIL" {
+				continue
+			}
+			if identity.IsPrimary != nil && *identity.IsPrimary {
+				return identity.Value
+			}
+			if email == "" {
+				email = identity.Value
+			}
+		}
+	}
+
+	if email == "" {
+		email = c.Properties["email"].String()
+	}
+
+	return email
+}
+
+// FormSubmission is a form submitted by a contact
+type FormSubmission struct {
+	ConversionID string        `json:"conversion-id"`
+	Timestamp    int64         `json:"timestamp"`
+	FormID       string        `json:"form-id"`
+	PortalID     int64         `json:"portal-id"`
+	PageURL      string        `json:"page-url"`
+	PageTitle    string        `json:"page-title,omitempty"`
+	Title        string        `json:"title,omitempty"`
+	FormType     string        `json:"form-type,omitempty"`
+	MetaData     []interface{} `json:"meta-data,omitempty"`
+	// Values are the submitted field values. They are only available for submissions read through the forms package.
+	Values []FormValue `json:"values,omitempty"`
+}
+
+// FormValue is the value of a single form field
+type FormValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 // IdentityProfile is a struct generated from the HubSpot API
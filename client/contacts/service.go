@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/retgits/hubspot/client/properties"
 )
 
 const (
@@ -23,13 +25,14 @@ type Contacts struct {
 	Count      int64
 	OffSet     int64
 	Properties []string
+	Validator  *properties.Validator
 }
 
 // New creates a new instance of the Contacts service with default settings. Because of the central role contacts play in the HubSpot application,
 // it is not surprising that most integrations with HubSpot either read or write Contacts data.
 func New(c *client.Client) *Contacts {
 	return &Contacts{
-		c, defaultCount, defaultOffSet, nil,
+		c, defaultCount, defaultOffSet, nil, nil,
 	}
 }
 
@@ -55,6 +58,13 @@ func (c *Contacts) WithProperties(props []string) *Contacts {
 	return c
 }
 
+// WithValidator sets a Validator that checks property values before they are sent to HubSpot, returning a Contacts
+// pointer for chaining.
+func (c *Contacts) WithValidator(v *properties.Validator) *Contacts {
+	c.Validator = v
+	return c
+}
+
 // GetRecentlyUpdatedContacts returns, for a given account, all contacts that have been recently updated or created.
 func (c *Contacts) GetRecentlyUpdatedContacts() ([]Contact, error) {
 	url := buildURL(c, recentlyUpdatedcontactsEndpoint)
@@ -99,8 +109,15 @@ func (c *Contacts) GetRecentlyUpdatedContacts() ([]Contact, error) {
 
 // UpdateContact is to update an existing contact in HubSpot. This method lets you update the properties of a contact in HubSpot.
 // The map[string]string represents the new values for the contact, where the map key is the name of the property and the map
-// value is the new value
+// value is the new value. When a Validator is set, the values are checked first and a *properties.ValidationError
+// lists every invalid property.
 func (c *Contacts) UpdateContact(contactID string, props map[string]string) error {
+	if c.Validator != nil {
+		if err := c.Validator.Validate(crm.Contacts, props); err != nil {
+			return err
+		}
+	}
+
 	url := buildURL(c, fmt.Sprintf(updateContactsEndpoint, contactID))
 
 	properties := make([]Property, 0)
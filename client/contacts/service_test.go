@@ -2,6 +2,7 @@
 package contacts
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/retgits/hubspot/client"
@@ -17,3 +18,15 @@ func TestClient(t *testing.T) {
 	contactsSvc := New(hubspot)
 	assert.Equal(t, contactsSvc.APIKey, apikey)
 }
+
+func TestContactEmail(t *testing.T) {
+	var contact Contact
+	err := json.Unmarshal([]byte(`{"vid":1,"identity-profiles":[{"vid":1,"identities":[
+		{"type":"EMAIL","value":"old@example.com","timestamp":1},
+		{"type":"LEAD_GUID","value":"f9f3e4d8","timestamp":1},
+		{"type":"EMAIL","value":"codey@example.com","timestamp":2,"is-primary":true}]}]}`), &contact)
+	assert.NoError(t, err)
+	assert.Equal(t, "codey@example.com", contact.Email())
+
+	assert.Equal(t, "", (&Contact{}).Email())
+}
@@ -0,0 +1,216 @@
+// Package crm covers the CRM Objects API (v3), which has the same endpoints for every type of object in the HubSpot
+// CRM. It can be used for contacts, companies, deals and tickets as well as for products, line items, quotes and
+// custom objects.
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+)
+
+// ObjectType is the name of a type of object in the CRM, as used in the URLs of the CRM Objects API
+type ObjectType string
+
+const (
+	// Contacts is the object type of contacts
+	Contacts ObjectType = "contacts"
+	// Companies is the object type of companies
+	Companies ObjectType = "companies"
+	// Deals is the object type of deals
+	Deals ObjectType = "deals"
+	// Tickets is the object type of tickets
+	Tickets ObjectType = "tickets"
+	// Products is the object type of products
+	Products ObjectType = "products"
+	// LineItems is the object type of line items
+	LineItems ObjectType = "line_items"
+	// Quotes is the object type of quotes
+	Quotes ObjectType = "quotes"
+)
+
+// Object is a single object in the HubSpot CRM
+type Object struct {
+	ID           string                     `json:"id"`
+	Properties   map[string]string          `json:"properties"`
+	CreatedAt    time.Time                  `json:"createdAt"`
+	UpdatedAt    time.Time                  `json:"updatedAt"`
+	Archived     bool                       `json:"archived"`
+	ArchivedAt   *time.Time                 `json:"archivedAt,omitempty"`
+	Associations map[string]AssociationList `json:"associations,omitempty"`
+}
+
+// Property returns the value of the named property, or an empty value when the object doesn't have it.
+func (o *Object) Property(name string) client.PropertyValue {
+	return client.PropertyValue{Value: o.Properties[name]}
+}
+
+// AssociationList contains the IDs of the objects of one type that are associated with an object
+type AssociationList struct {
+	Results []AssociatedObject `json:"results"`
+	Paging  *Paging            `json:"paging,omitempty"`
+}
+
+// AssociatedObject is an object associated with another object
+type AssociatedObject struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// Paging is a struct generated from the HubSpot API
+type Paging struct {
+	Next *NextPage `json:"next,omitempty"`
+}
+
+// NextPage is a struct generated from the HubSpot API
+type NextPage struct {
+	After string `json:"after"`
+	Link  string `json:"link,omitempty"`
+}
+
+// ObjectList is a single page of objects
+type ObjectList struct {
+	Results []Object `json:"results"`
+	Paging  *Paging  `json:"paging,omitempty"`
+}
+
+// After returns the cursor of the next page, or an empty string when this is the last page
+func (r *ObjectList) After() string {
+	if r.Paging == nil || r.Paging.Next == nil {
+		return ""
+	}
+	return r.Paging.Next.After
+}
+
+// ObjectInput is a struct used to create or update an object
+type ObjectInput struct {
+	ID         string            `json:"id,omitempty"`
+	Properties map[string]string `json:"properties"`
+}
+
+// ObjectID is a struct used to refer to an object in batch calls
+type ObjectID struct {
+	ID string `json:"id"`
+}
+
+// BatchReadInput is the payload sent to read objects in bulk
+type BatchReadInput struct {
+	Inputs     []ObjectID `json:"inputs"`
+	Properties []string   `json:"properties,omitempty"`
+}
+
+// BatchIDInput is the payload sent to archive objects in bulk
+type BatchIDInput struct {
+	Inputs []ObjectID `json:"inputs"`
+}
+
+// BatchInput is the payload sent to create or update objects in bulk
+type BatchInput struct {
+	Inputs []ObjectInput `json:"inputs"`
+}
+
+// BatchResult is the payload returned after a batch call
+type BatchResult struct {
+	Status      string       `json:"status"`
+	Results     []Object     `json:"results"`
+	NumErrors   int64        `json:"numErrors,omitempty"`
+	Errors      []BatchError `json:"errors,omitempty"`
+	StartedAt   time.Time    `json:"startedAt"`
+	CompletedAt time.Time    `json:"completedAt"`
+}
+
+// Err returns an error with the messages of the objects that failed in the batch call, or nil when all of
+// them succeeded
+func (r *BatchResult) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0)
+	for idx := range r.Errors {
+		messages = append(messages, r.Errors[idx].Message)
+	}
+
+	return fmt.Errorf("%d errors in batch call: %s", len(r.Errors), strings.Join(messages, "; "))
+}
+
+// BatchError is an error for some of the objects in a batch call
+type BatchError struct {
+	Status   string              `json:"status"`
+	Category string              `json:"category"`
+	Message  string              `json:"message"`
+	Context  map[string][]string `json:"context,omitempty"`
+}
+
+// Filter is a single condition in a search request
+type Filter struct {
+	PropertyName string   `json:"propertyName"`
+	Operator     string   `json:"operator"`
+	Value        string   `json:"value,omitempty"`
+	HighValue    string   `json:"highValue,omitempty"`
+	Values       []string `json:"values,omitempty"`
+}
+
+// FilterGroup contains filters that all must match. Objects match a search request when they match
+// any of its filter groups.
+type FilterGroup struct {
+	Filters []Filter `json:"filters"`
+}
+
+// Sort is the sort order of a search request
+type Sort struct {
+	PropertyName string `json:"propertyName"`
+	Direction    string `json:"direction"`
+}
+
+// SearchRequest is the payload sent to search for objects
+type SearchRequest struct {
+	FilterGroups []FilterGroup `json:"filterGroups,omitempty"`
+	Sorts        []Sort        `json:"sorts,omitempty"`
+	Query        string        `json:"query,omitempty"`
+	Properties   []string      `json:"properties,omitempty"`
+	Limit        int64         `json:"limit,omitempty"`
+	After        string        `json:"after,omitempty"`
+}
+
+// SearchResult is a single page of objects matching a search request
+type SearchResult struct {
+	Total   int64    `json:"total"`
+	Results []Object `json:"results"`
+	Paging  *Paging  `json:"paging,omitempty"`
+}
+
+// After returns the cursor of the next page, or an empty string when this is the last page
+func (r *SearchResult) After() string {
+	if r.Paging == nil || r.Paging.Next == nil {
+		return ""
+	}
+	return r.Paging.Next.After
+}
+
+func unmarshalObject(data []byte) (Object, error) {
+	var r Object
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalObjectList(data []byte) (ObjectList, error) {
+	var r ObjectList
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalBatchResult(data []byte) (BatchResult, error) {
+	var r BatchResult
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalSearchResult(data []byte) (SearchResult, error) {
+	var r SearchResult
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,386 @@
+// Package crm covers the CRM Objects API (v3), which has the same endpoints for every type of object in the HubSpot
+// CRM. It can be used for contacts, companies, deals and tickets as well as for products, line items, quotes and
+// custom objects.
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	defaultLimit int64 = 100
+	// batchSize is the largest number of objects HubSpot accepts in a single batch call
+	batchSize = 100
+	// batchStatusComplete is the status of a batch call that has completed
+	batchStatusComplete = "COMPLETE"
+	// objectsEndpoint is the endpoint to list and create objects
+	objectsEndpoint = "crm/v3/objects/%s"
+	// objectEndpoint is the endpoint to get, update and archive a single object
+	objectEndpoint = "crm/v3/objects/%s/%s"
+	// batchReadEndpoint is the endpoint to get a group of objects by ID
+	batchReadEndpoint = "crm/v3/objects/%s/batch/read"
+	// batchCreateEndpoint is the endpoint to create a group of objects
+	batchCreateEndpoint = "crm/v3/objects/%s/batch/create"
+	// batchUpdateEndpoint is the endpoint to update a group of objects
+	batchUpdateEndpoint = "crm/v3/objects/%s/batch/update"
+	// batchArchiveEndpoint is the endpoint to archive a group of objects
+	batchArchiveEndpoint = "crm/v3/objects/%s/batch/archive"
+	// searchEndpoint is the endpoint to search for objects
+	searchEndpoint = "crm/v3/objects/%s/search"
+)
+
+// Objects contains the elements to communicate with the HubSpot CRM Objects endpoints for one type of object.
+type Objects struct {
+	*client.Client
+	ObjectType   ObjectType
+	Limit        int64
+	Properties   []string
+	Associations []string
+	Archived     bool
+}
+
+// New creates a new instance of the Objects service for the given object type with default settings. For
+// custom objects the object type is the fully qualified name or the object type ID of the custom object.
+func New(c *client.Client, objectType ObjectType) *Objects {
+	return &Objects{
+		c, objectType, defaultLimit, nil, nil, false,
+	}
+}
+
+// WithLimit sets the number of objects returned per page, returning an Objects pointer for chaining.
+func (o *Objects) WithLimit(limit int64) *Objects {
+	o.Limit = limit
+	return o
+}
+
+// WithProperties sets the properties returned for each object, returning an Objects pointer for chaining.
+func (o *Objects) WithProperties(props []string) *Objects {
+	o.Properties = props
+	return o
+}
+
+// WithAssociations sets the object types for which the IDs of associated objects are returned, returning an
+// Objects pointer for chaining.
+func (o *Objects) WithAssociations(objectTypes []string) *Objects {
+	o.Associations = objectTypes
+	return o
+}
+
+// WithArchived sets whether archived objects are returned instead of active ones, returning an Objects pointer
+// for chaining.
+func (o *Objects) WithArchived(archived bool) *Objects {
+	o.Archived = archived
+	return o
+}
+
+// GetObject gets a single object by its ID.
+func (o *Objects) GetObject(objectID string) (Object, error) {
+	url := buildURL(o, fmt.Sprintf(objectEndpoint, o.ObjectType, objectID), true)
+
+	res, err := o.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Object{}, err
+	}
+
+	return unmarshalObject(res)
+}
+
+// ListObjects gets a single page of objects. Pass an empty after to get the first page, and the After of the
+// returned list to get the next one.
+func (o *Objects) ListObjects(after string) (ObjectList, error) {
+	url := listURL(o, after)
+
+	res, err := o.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return ObjectList{}, err
+	}
+
+	return unmarshalObjectList(res)
+}
+
+// GetAllObjects gets all objects of the object type, following the paging cursor until the last page.
+func (o *Objects) GetAllObjects() ([]Object, error) {
+	temp, err := o.ListObjects("")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := temp.Results
+
+	for temp.After() != "" {
+		temp, err = o.ListObjects(temp.After())
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, temp.Results...)
+	}
+
+	return objects, nil
+}
+
+// CreateObject creates an object with the given property values.
+func (o *Objects) CreateObject(props map[string]string) (Object, error) {
+	url := buildURL(o, fmt.Sprintf(objectsEndpoint, o.ObjectType), false)
+
+	input := ObjectInput{
+		Properties: props,
+	}
+
+	return o.send(url, http.MethodPost, input)
+}
+
+// UpdateObject updates the given properties of an object. Properties that aren't in the map keep their value.
+func (o *Objects) UpdateObject(objectID string, props map[string]string) (Object, error) {
+	url := buildURL(o, fmt.Sprintf(objectEndpoint, o.ObjectType, objectID), false)
+
+	input := ObjectInput{
+		Properties: props,
+	}
+
+	return o.send(url, http.MethodPatch, input)
+}
+
+// ArchiveObject moves an object to the recycling bin.
+func (o *Objects) ArchiveObject(objectID string) error {
+	url := buildURL(o, fmt.Sprintf(objectEndpoint, o.ObjectType, objectID), false)
+
+	_, err := o.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// BatchReadObjects gets a group of objects by their IDs. Groups larger than HubSpot's batch limit are read in
+// several calls, and the results are combined. When one of the calls fails, the results of the calls before it
+// are returned together with the error.
+func (o *Objects) BatchReadObjects(objectIDs []string) (BatchResult, error) {
+	url := buildURL(o, fmt.Sprintf(batchReadEndpoint, o.ObjectType), false)
+
+	results := make([]BatchResult, 0)
+	for _, ids := range chunkIDs(objectIDs) {
+		input := BatchReadInput{
+			Inputs:     newObjectIDs(ids),
+			Properties: o.Properties,
+		}
+
+		res, err := o.sendBatch(url, input)
+		if err != nil {
+			return combineBatchResults(results), err
+		}
+		results = append(results, res)
+	}
+
+	return combineBatchResults(results), nil
+}
+
+// BatchCreateObjects creates a group of objects. Each map in props contains the property values of one object.
+// Groups larger than HubSpot's batch limit are created in several calls. When one of the calls fails, the objects
+// created by the calls before it are returned together with the error, so they aren't created again on a retry.
+func (o *Objects) BatchCreateObjects(props []map[string]string) (BatchResult, error) {
+	inputs := make([]ObjectInput, 0)
+	for idx := range props {
+		inputs = append(inputs, ObjectInput{Properties: props[idx]})
+	}
+
+	return o.sendInputs(fmt.Sprintf(batchCreateEndpoint, o.ObjectType), inputs)
+}
+
+// BatchUpdateObjects updates a group of objects. The map is keyed by object ID, and the values are the new
+// property values of that object. Groups larger than HubSpot's batch limit are updated in several calls. When one
+// of the calls fails, the objects updated by the calls before it are returned together with the error.
+func (o *Objects) BatchUpdateObjects(props map[string]map[string]string) (BatchResult, error) {
+	inputs := make([]ObjectInput, 0)
+	for objectID, objectProps := range props {
+		inputs = append(inputs, ObjectInput{ID: objectID, Properties: objectProps})
+	}
+
+	return o.sendInputs(fmt.Sprintf(batchUpdateEndpoint, o.ObjectType), inputs)
+}
+
+// BatchArchiveObjects archives a group of objects by their IDs. Groups larger than HubSpot's batch limit are
+// archived in several calls.
+func (o *Objects) BatchArchiveObjects(objectIDs []string) error {
+	url := buildURL(o, fmt.Sprintf(batchArchiveEndpoint, o.ObjectType), false)
+
+	for _, ids := range chunkIDs(objectIDs) {
+		input := BatchIDInput{
+			Inputs: newObjectIDs(ids),
+		}
+
+		payload, err := json.Marshal(input)
+		if err != nil {
+			return err
+		}
+
+		if _, err := o.Call(url, http.MethodPost, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Search gets a single page of objects matching the search request. When the request doesn't list any
+// properties, the properties set with WithProperties are returned.
+func (o *Objects) Search(request SearchRequest) (SearchResult, error) {
+	url := buildURL(o, fmt.Sprintf(searchEndpoint, o.ObjectType), false)
+
+	if len(request.Properties) == 0 {
+		request.Properties = o.Properties
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	res, err := o.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	return unmarshalSearchResult(res)
+}
+
+// send marshals the payload, sends it and unmarshals the object that HubSpot returns.
+func (o *Objects) send(url string, httpMethod string, v interface{}) (Object, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return Object{}, err
+	}
+
+	res, err := o.Call(url, httpMethod, payload)
+	if err != nil {
+		return Object{}, err
+	}
+
+	return unmarshalObject(res)
+}
+
+// sendBatch marshals the payload, sends it to a batch endpoint and unmarshals the result.
+func (o *Objects) sendBatch(url string, v interface{}) (BatchResult, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	res, err := o.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	return unmarshalBatchResult(res)
+}
+
+// sendInputs sends the inputs to a batch create or update endpoint, in chunks of at most batchSize inputs. When a
+// chunk fails, the combined results of the chunks before it are returned with the error.
+func (o *Objects) sendInputs(endpoint string, inputs []ObjectInput) (BatchResult, error) {
+	url := buildURL(o, endpoint, false)
+
+	results := make([]BatchResult, 0)
+	for start := 0; start < len(inputs); start += batchSize {
+		end := start + batchSize
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		res, err := o.sendBatch(url, BatchInput{Inputs: inputs[start:end]})
+		if err != nil {
+			return combineBatchResults(results), err
+		}
+		results = append(results, res)
+	}
+
+	return combineBatchResults(results), nil
+}
+
+// chunkIDs splits the IDs into chunks of at most batchSize IDs.
+func chunkIDs(objectIDs []string) [][]string {
+	chunks := make([][]string, 0)
+	for start := 0; start < len(objectIDs); start += batchSize {
+		end := start + batchSize
+		if end > len(objectIDs) {
+			end = len(objectIDs)
+		}
+		chunks = append(chunks, objectIDs[start:end])
+	}
+	return chunks
+}
+
+// combineBatchResults combines the results of the batch calls for one group of objects into a single result. The
+// status is COMPLETE only when all calls completed.
+func combineBatchResults(results []BatchResult) BatchResult {
+	if len(results) == 0 {
+		return BatchResult{Status: batchStatusComplete, Results: []Object{}}
+	}
+
+	combined := results[0]
+	for _, r := range results[1:] {
+		combined.Results = append(combined.Results, r.Results...)
+		combined.Errors = append(combined.Errors, r.Errors...)
+		combined.NumErrors += r.NumErrors
+
+		if r.Status != batchStatusComplete {
+			combined.Status = r.Status
+		}
+		if r.StartedAt.Before(combined.StartedAt) {
+			combined.StartedAt = r.StartedAt
+		}
+		if r.CompletedAt.After(combined.CompletedAt) {
+			combined.CompletedAt = r.CompletedAt
+		}
+	}
+
+	return combined
+}
+
+func newObjectIDs(objectIDs []string) []ObjectID {
+	ids := make([]ObjectID, 0)
+	for idx := range objectIDs {
+		ids = append(ids, ObjectID{ID: objectIDs[idx]})
+	}
+	return ids
+}
+
+// Construct the URL to list a page of objects
+func listURL(o *Objects, after string) string {
+	url := buildURL(o, fmt.Sprintf(objectsEndpoint, o.ObjectType), true)
+
+	if o.Limit > 0 {
+		url = fmt.Sprintf("%s&limit=%d", url, o.Limit)
+	}
+
+	if after != "" {
+		url = fmt.Sprintf("%s&after=%s", url, neturl.QueryEscape(after))
+	}
+
+	return url
+}
+
+// Construct the proper URL to call
+func buildURL(o *Objects, u string, read bool) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, o.APIKey)
+
+	if !read {
+		return url
+	}
+
+	for idx := range o.Properties {
+		url = fmt.Sprintf("%s&properties=%s", url, o.Properties[idx])
+	}
+
+	for idx := range o.Associations {
+		url = fmt.Sprintf("%s&associations=%s", url, o.Associations[idx])
+	}
+
+	if o.Archived {
+		url = fmt.Sprintf("%s&archived=true", url)
+	}
+
+	return url
+}
@@ -0,0 +1,81 @@
+// Package crm covers the CRM Objects API (v3), which has the same endpoints for every type of object in the HubSpot
+// CRM. It can be used for contacts, companies, deals and tickets as well as for products, line items, quotes and
+// custom objects.
+package crm
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildURL(t *testing.T) {
+	o := New(client.NewClient().WithAPIKey("demo"), Deals)
+	assert.Equal(t, "crm/v3/objects/deals?hapikey=demo", buildURL(o, "crm/v3/objects/deals", true))
+
+	o = o.WithProperties([]string{"dealname", "amount"}).WithAssociations([]string{"contacts"}).WithArchived(true)
+	assert.Equal(t, "crm/v3/objects/deals/1?hapikey=demo&properties=dealname&properties=amount&associations=contacts&archived=true",
+		buildURL(o, "crm/v3/objects/deals/1", true))
+	assert.Equal(t, "crm/v3/objects/deals/1?hapikey=demo", buildURL(o, "crm/v3/objects/deals/1", false))
+}
+
+func TestListURL(t *testing.T) {
+	o := New(client.NewClient().WithAPIKey("demo"), LineItems).WithLimit(10)
+	assert.Equal(t, "crm/v3/objects/line_items?hapikey=demo&limit=10", listURL(o, ""))
+	assert.Equal(t, "crm/v3/objects/line_items?hapikey=demo&limit=10&after=MTA%2B%2F%3D", listURL(o, "MTA+/="))
+}
+
+func TestBatchPayloads(t *testing.T) {
+	read, err := json.Marshal(BatchReadInput{Inputs: newObjectIDs([]string{"1", "2"}), Properties: []string{"name"}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"inputs":[{"id":"1"},{"id":"2"}],"properties":["name"]}`, string(read))
+
+	archive, err := json.Marshal(BatchIDInput{Inputs: newObjectIDs([]string{"3"})})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"inputs":[{"id":"3"}]}`, string(archive))
+
+	update, err := json.Marshal(BatchInput{Inputs: []ObjectInput{{ID: "4", Properties: map[string]string{"price": "9.99"}}}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"inputs":[{"id":"4","properties":{"price":"9.99"}}]}`, string(update))
+
+	create, err := json.Marshal(BatchInput{Inputs: []ObjectInput{{Properties: map[string]string{"name": "Widget"}}}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"inputs":[{"properties":{"name":"Widget"}}]}`, string(create))
+}
+
+func TestChunkIDs(t *testing.T) {
+	ids := make([]string, 0)
+	for i := 0; i < 250; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+
+	chunks := chunkIDs(ids)
+	assert.Len(t, chunks, 3)
+	assert.Len(t, chunks[0], 100)
+	assert.Len(t, chunks[1], 100)
+	assert.Equal(t, []string{"200", "249"}, []string{chunks[2][0], chunks[2][49]})
+	assert.Empty(t, chunkIDs(nil))
+}
+
+func TestCombineBatchResults(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	combined := combineBatchResults([]BatchResult{
+		{Status: "COMPLETE", Results: []Object{{ID: "1"}}, StartedAt: start.Add(time.Second), CompletedAt: start.Add(2 * time.Second)},
+		{Status: "COMPLETE", Results: []Object{{ID: "2"}}, NumErrors: 1, Errors: []BatchError{{Message: "not found"}}, StartedAt: start, CompletedAt: start.Add(3 * time.Second)},
+	})
+
+	assert.Equal(t, "COMPLETE", combined.Status)
+	assert.Equal(t, []Object{{ID: "1"}, {ID: "2"}}, combined.Results)
+	assert.Equal(t, int64(1), combined.NumErrors)
+	assert.Equal(t, start, combined.StartedAt)
+	assert.Equal(t, start.Add(3*time.Second), combined.CompletedAt)
+	assert.EqualError(t, combined.Err(), "1 errors in batch call: not found")
+
+	empty := combineBatchResults(nil)
+	assert.Equal(t, "COMPLETE", empty.Status)
+	assert.NoError(t, empty.Err())
+}
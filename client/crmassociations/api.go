@@ -13,6 +13,101 @@ type Associations struct {
 	Offset  int64   `json:"offset"`
 }
 
+// Category is the category of an association definition
+type Category string
+
+const (
+	// HubSpotDefined is the category of the association types that are built into HubSpot
+	HubSpotDefined Category = "HUBSPOT_DEFINED"
+	// UserDefined is the category of association types (labels) created in a portal
+	UserDefined Category = "USER_DEFINED"
+)
+
+// DefinitionID is the ID of a HUBSPOT_DEFINED association type
+type DefinitionID int64
+
+// The HUBSPOT_DEFINED association types. The name of each constant reads as the direction of the
+// association, so ContactToCompany gets the companies of a contact.
+const (
+	ContactToCompany            DefinitionID = 1
+	CompanyToContact            DefinitionID = 2
+	DealToContact               DefinitionID = 3
+	ContactToDeal               DefinitionID = 4
+	DealToCompany               DefinitionID = 5
+	CompanyToDeal               DefinitionID = 6
+	CompanyToEngagement         DefinitionID = 7
+	EngagementToCompany         DefinitionID = 8
+	ContactToEngagement         DefinitionID = 9
+	EngagementToContact         DefinitionID = 10
+	DealToEngagement            DefinitionID = 11
+	EngagementToDeal            DefinitionID = 12
+	ParentCompanyToChildCompany DefinitionID = 13
+	ChildCompanyToParentCompany DefinitionID = 14
+	ContactToTicket             DefinitionID = 15
+	TicketToContact             DefinitionID = 16
+	TicketToEngagement          DefinitionID = 17
+	EngagementToTicket          DefinitionID = 18
+	DealToLineItem              DefinitionID = 19
+	LineItemToDeal              DefinitionID = 20
+	CompanyToTicket             DefinitionID = 25
+	TicketToCompany             DefinitionID = 26
+	DealToTicket                DefinitionID = 27
+	TicketToDeal                DefinitionID = 28
+	DealToQuote                 DefinitionID = 63
+	QuoteToDeal                 DefinitionID = 64
+	QuoteToLineItem             DefinitionID = 67
+	LineItemToQuote             DefinitionID = 68
+	QuoteToContact              DefinitionID = 69
+	ContactToQuote              DefinitionID = 70
+	QuoteToCompany              DefinitionID = 71
+	CompanyToQuote              DefinitionID = 72
+)
+
+// Definition identifies an association type by its category and ID
+type Definition struct {
+	Category Category
+	ID       int64
+}
+
+// Definition returns the HUBSPOT_DEFINED Definition for the association type
+func (d DefinitionID) Definition() Definition {
+	return Definition{
+		Category: HubSpotDefined,
+		ID:       int64(d),
+	}
+}
+
+// UserDefinedDefinition returns the Definition of an association type created in a portal
+func UserDefinedDefinition(definitionID int64) Definition {
+	return Definition{
+		Category: UserDefined,
+		ID:       definitionID,
+	}
+}
+
+// Association is a link between two objects in the HubSpot CRM
+type Association struct {
+	FromObjectID int64    `json:"fromObjectId"`
+	ToObjectID   int64    `json:"toObjectId"`
+	Category     Category `json:"category"`
+	DefinitionID int64    `json:"definitionId"`
+}
+
+// NewAssociation returns the Association of the given type between two objects
+func NewAssociation(fromObjectID int64, toObjectID int64, definition Definition) Association {
+	return Association{
+		FromObjectID: fromObjectID,
+		ToObjectID:   toObjectID,
+		Category:     definition.Category,
+		DefinitionID: definition.ID,
+	}
+}
+
+// Marshal takes an Association struct and transforms it into a byte array
+func (r *Association) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
 func unmarshalAssociations(data []byte) (Associations, error) {
 	var r Associations
 	err := json.Unmarshal(data, &r)
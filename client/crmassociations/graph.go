@@ -0,0 +1,247 @@
+// Package crmassociations covers the CRM Associations API is used to manage associations between objects in the HubSpot CRM.
+// This includes the association between a contact and its company, between a company and a parent or child company, between
+// deal and a company or contact, or between a ticket and a contact or company, as well as associations between engagements
+// and other objects.
+package crmassociations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+const (
+	defaultMaxDepth    = 2
+	defaultConcurrency = 4
+)
+
+// ObjectType is the type of an object in the HubSpot CRM
+type ObjectType string
+
+const (
+	// ContactObject is the object type of contacts
+	ContactObject ObjectType = "CONTACT"
+	// CompanyObject is the object type of companies
+	CompanyObject ObjectType = "COMPANY"
+	// DealObject is the object type of deals
+	DealObject ObjectType = "DEAL"
+	// TicketObject is the object type of tickets
+	TicketObject ObjectType = "TICKET"
+	// EngagementObject is the object type of engagements
+	EngagementObject ObjectType = "ENGAGEMENT"
+	// LineItemObject is the object type of line items
+	LineItemObject ObjectType = "LINE_ITEM"
+	// QuoteObject is the object type of quotes
+	QuoteObject ObjectType = "QUOTE"
+)
+
+// definitionObjectTypes contains the object types on both ends of the HUBSPOT_DEFINED association types
+var definitionObjectTypes = map[DefinitionID][2]ObjectType{
+	ContactToCompany:            {ContactObject, CompanyObject},
+	CompanyToContact:            {CompanyObject, ContactObject},
+	DealToContact:               {DealObject, ContactObject},
+	ContactToDeal:               {ContactObject, DealObject},
+	DealToCompany:               {DealObject, CompanyObject},
+	CompanyToDeal:               {CompanyObject, DealObject},
+	CompanyToEngagement:         {CompanyObject, EngagementObject},
+	EngagementToCompany:         {EngagementObject, CompanyObject},
+	ContactToEngagement:         {ContactObject, EngagementObject},
+	EngagementToContact:         {EngagementObject, ContactObject},
+	DealToEngagement:            {DealObject, EngagementObject},
+	EngagementToDeal:            {EngagementObject, DealObject},
+	ParentCompanyToChildCompany: {CompanyObject, CompanyObject},
+	ChildCompanyToParentCompany: {CompanyObject, CompanyObject},
+	ContactToTicket:             {ContactObject, TicketObject},
+	TicketToContact:             {TicketObject, ContactObject},
+	TicketToEngagement:          {TicketObject, EngagementObject},
+	EngagementToTicket:          {EngagementObject, TicketObject},
+	DealToLineItem:              {DealObject, LineItemObject},
+	LineItemToDeal:              {LineItemObject, DealObject},
+	CompanyToTicket:             {CompanyObject, TicketObject},
+	TicketToCompany:             {TicketObject, CompanyObject},
+	DealToTicket:                {DealObject, TicketObject},
+	TicketToDeal:                {TicketObject, DealObject},
+	DealToQuote:                 {DealObject, QuoteObject},
+	QuoteToDeal:                 {QuoteObject, DealObject},
+	QuoteToLineItem:             {QuoteObject, LineItemObject},
+	LineItemToQuote:             {LineItemObject, QuoteObject},
+	QuoteToContact:              {QuoteObject, ContactObject},
+	ContactToQuote:              {ContactObject, QuoteObject},
+	QuoteToCompany:              {QuoteObject, CompanyObject},
+	CompanyToQuote:              {CompanyObject, QuoteObject},
+}
+
+// From returns the object type the association type starts from, or an empty ObjectType for unknown definitions
+func (d DefinitionID) From() ObjectType {
+	return definitionObjectTypes[d][0]
+}
+
+// To returns the object type the association type points to, or an empty ObjectType for unknown definitions
+func (d DefinitionID) To() ObjectType {
+	return definitionObjectTypes[d][1]
+}
+
+// Object identifies a single object in the HubSpot CRM
+type Object struct {
+	Type ObjectType `json:"type"`
+	ID   int64      `json:"id"`
+}
+
+// String returns the object as TYPE:ID
+func (o Object) String() string {
+	return fmt.Sprintf("%s:%d", o.Type, o.ID)
+}
+
+// Node is an object found while walking the associations, with the number of associations between it and
+// the object the walk started from
+type Node struct {
+	Object
+	Depth int `json:"depth"`
+}
+
+// Edge is an association between two objects found while walking the associations
+type Edge struct {
+	From         Object       `json:"from"`
+	To           Object       `json:"to"`
+	DefinitionID DefinitionID `json:"definitionId"`
+}
+
+// Graph contains the objects and associations found while walking the associations. Nodes are ordered by depth.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// JSON transforms the graph into a byte array
+func (g *Graph) JSON() ([]byte, error) {
+	return json.Marshal(g)
+}
+
+// DOT transforms the graph into the Graphviz DOT language
+func (g *Graph) DOT() string {
+	var buf bytes.Buffer
+
+	buf.WriteString("digraph associations {\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&buf, "\t%q [label=\"%s %d\"];\n", n.String(), n.Type, n.ID)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&buf, "\t%q -> %q [label=\"%d\"];\n", e.From.String(), e.To.String(), e.DefinitionID)
+	}
+	buf.WriteString("}\n")
+
+	return buf.String()
+}
+
+// Walker does a breadth-first walk over the associations of an object, to collect everything that is related to it.
+type Walker struct {
+	MaxDepth    int
+	Concurrency int
+	fetch       func(objectID int64, definition Definition) ([]int64, error)
+}
+
+// NewWalker creates a new Walker that uses the CRMAssociations service to get associations, with default settings.
+func NewWalker(c *CRMAssociations) *Walker {
+	return &Walker{
+		MaxDepth:    defaultMaxDepth,
+		Concurrency: defaultConcurrency,
+		fetch:       c.GetAssociations,
+	}
+}
+
+// WithMaxDepth sets the number of associations the walk follows away from the start object, returning a
+// Walker pointer for chaining.
+func (w *Walker) WithMaxDepth(depth int) *Walker {
+	w.MaxDepth = depth
+	return w
+}
+
+// WithConcurrency sets the number of calls made to HubSpot at the same time, returning a Walker pointer for
+// chaining.
+func (w *Walker) WithConcurrency(concurrency int) *Walker {
+	w.Concurrency = concurrency
+	return w
+}
+
+// Walk collects the objects associated with start, following only the given association types. For every object
+// found, the association types that start from its object type are followed until MaxDepth is reached. Every
+// object is visited only once.
+func (w *Walker) Walk(start Object, definitions []DefinitionID) (Graph, error) {
+	type job struct {
+		from       Object
+		definition DefinitionID
+		results    []int64
+	}
+
+	concurrency := w.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	graph := Graph{
+		Nodes: []Node{{Object: start}},
+		Edges: make([]Edge, 0),
+	}
+	visited := map[Object]bool{start: true}
+	edges := make(map[Edge]bool)
+	frontier := []Object{start}
+
+	for depth := 1; depth <= w.MaxDepth && len(frontier) > 0; depth++ {
+		jobs := make([]*job, 0)
+		for _, obj := range frontier {
+			for _, def := range definitions {
+				if def.From() == obj.Type {
+					jobs = append(jobs, &job{from: obj, definition: def})
+				}
+			}
+		}
+
+		var wg sync.WaitGroup
+		var once sync.Once
+		var firstErr error
+		sem := make(chan struct{}, concurrency)
+
+		for _, j := range jobs {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(j *job) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				results, err := w.fetch(j.from.ID, j.definition.Definition())
+				if err != nil {
+					once.Do(func() { firstErr = err })
+					return
+				}
+				j.results = results
+			}(j)
+		}
+		wg.Wait()
+
+		if firstErr != nil {
+			return graph, firstErr
+		}
+
+		frontier = make([]Object, 0)
+		for _, j := range jobs {
+			for _, id := range j.results {
+				to := Object{Type: j.definition.To(), ID: id}
+
+				edge := Edge{From: j.from, To: to, DefinitionID: j.definition}
+				if !edges[edge] {
+					edges[edge] = true
+					graph.Edges = append(graph.Edges, edge)
+				}
+
+				if !visited[to] {
+					visited[to] = true
+					graph.Nodes = append(graph.Nodes, Node{Object: to, Depth: depth})
+					frontier = append(frontier, to)
+				}
+			}
+		}
+	}
+
+	return graph, nil
+}
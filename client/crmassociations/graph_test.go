@@ -0,0 +1,46 @@
+// Package crmassociations covers the CRM Associations API is used to manage associations between objects in the HubSpot CRM.
+// This includes the association between a contact and its company, between a company and a parent or child company, between
+// deal and a company or contact, or between a ticket and a contact or company, as well as associations between engagements
+// and other objects.
+package crmassociations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalk(t *testing.T) {
+	associations := map[Definition]map[int64][]int64{
+		CompanyToContact.Definition(): {1: {10, 11}},
+		CompanyToDeal.Definition():    {1: {20}},
+		ContactToCompany.Definition(): {10: {1}, 11: {1, 2}},
+		DealToContact.Definition():    {20: {10}},
+	}
+
+	w := &Walker{
+		MaxDepth:    2,
+		Concurrency: 2,
+		fetch: func(objectID int64, definition Definition) ([]int64, error) {
+			return associations[definition][objectID], nil
+		},
+	}
+
+	start := Object{Type: CompanyObject, ID: 1}
+	graph, err := w.Walk(start, []DefinitionID{CompanyToContact, CompanyToDeal, ContactToCompany, DealToContact})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Node{
+		{Object: start},
+		{Object: Object{Type: ContactObject, ID: 10}, Depth: 1},
+		{Object: Object{Type: ContactObject, ID: 11}, Depth: 1},
+		{Object: Object{Type: DealObject, ID: 20}, Depth: 1},
+		{Object: Object{Type: CompanyObject, ID: 2}, Depth: 2},
+	}, graph.Nodes)
+	assert.Len(t, graph.Edges, 7)
+
+	dot := graph.DOT()
+	assert.True(t, strings.HasPrefix(dot, "digraph associations {"))
+	assert.Contains(t, dot, `"CONTACT:11" -> "COMPANY:2" [label="1"];`)
+}
@@ -5,6 +5,7 @@
 package crmassociations
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -16,6 +17,16 @@ const (
 	defaultLimit  int64 = 100
 	// associationsEndpoint is the endpoint to retrieve all associations for an object
 	associationsEndpoint = "crm-associations/v1/associations/%s/HUBSPOT_DEFINED/%s"
+	// definitionAssociationsEndpoint is the endpoint to retrieve all associations of a given category and definition for an object
+	definitionAssociationsEndpoint = "crm-associations/v1/associations/%d/%s/%d"
+	// createAssociationEndpoint is the endpoint to create an association
+	createAssociationEndpoint = "crm-associations/v1/associations"
+	// createAssociationsEndpoint is the endpoint to create multiple associations
+	createAssociationsEndpoint = "crm-associations/v1/associations/create-batch"
+	// deleteAssociationEndpoint is the endpoint to delete an association
+	deleteAssociationEndpoint = "crm-associations/v1/associations/delete"
+	// deleteAssociationsEndpoint is the endpoint to delete multiple associations
+	deleteAssociationsEndpoint = "crm-associations/v1/associations/delete-batch"
 )
 
 // CRMAssociations contains the elements to communicate with the HubSpot CRM Associations endpoints.
@@ -48,7 +59,118 @@ func (c *CRMAssociations) WithLimit(limit int64) *CRMAssociations {
 
 // GetAssociationsForCRMObject gets the IDs of objects associated with the given object, based on the specified association type.
 func (c *CRMAssociations) GetAssociationsForCRMObject(objectID string, definitionID string) ([]int64, error) {
-	url := buildURL(c, fmt.Sprintf(associationsEndpoint, objectID, definitionID))
+	return c.getAssociations(fmt.Sprintf(associationsEndpoint, objectID, definitionID))
+}
+
+// GetAssociations gets the IDs of objects associated with the given object, based on the specified association type.
+// Use the Definition method of the DefinitionID constants for HUBSPOT_DEFINED types, and UserDefinedDefinition for
+// association types created in the portal.
+func (c *CRMAssociations) GetAssociations(objectID int64, definition Definition) ([]int64, error) {
+	return c.getAssociations(fmt.Sprintf(definitionAssociationsEndpoint, objectID, definition.Category, definition.ID))
+}
+
+// ContactCompanies gets the IDs of the companies associated with a contact.
+func (c *CRMAssociations) ContactCompanies(contactID int64) ([]int64, error) {
+	return c.GetAssociations(contactID, ContactToCompany.Definition())
+}
+
+// CompanyContacts gets the IDs of the contacts associated with a company.
+func (c *CRMAssociations) CompanyContacts(companyID int64) ([]int64, error) {
+	return c.GetAssociations(companyID, CompanyToContact.Definition())
+}
+
+// DealContacts gets the IDs of the contacts associated with a deal.
+func (c *CRMAssociations) DealContacts(dealID int64) ([]int64, error) {
+	return c.GetAssociations(dealID, DealToContact.Definition())
+}
+
+// ContactDeals gets the IDs of the deals associated with a contact.
+func (c *CRMAssociations) ContactDeals(contactID int64) ([]int64, error) {
+	return c.GetAssociations(contactID, ContactToDeal.Definition())
+}
+
+// DealCompanies gets the IDs of the companies associated with a deal.
+func (c *CRMAssociations) DealCompanies(dealID int64) ([]int64, error) {
+	return c.GetAssociations(dealID, DealToCompany.Definition())
+}
+
+// CompanyDeals gets the IDs of the deals associated with a company.
+func (c *CRMAssociations) CompanyDeals(companyID int64) ([]int64, error) {
+	return c.GetAssociations(companyID, CompanyToDeal.Definition())
+}
+
+// ContactTickets gets the IDs of the tickets associated with a contact.
+func (c *CRMAssociations) ContactTickets(contactID int64) ([]int64, error) {
+	return c.GetAssociations(contactID, ContactToTicket.Definition())
+}
+
+// TicketContacts gets the IDs of the contacts associated with a ticket.
+func (c *CRMAssociations) TicketContacts(ticketID int64) ([]int64, error) {
+	return c.GetAssociations(ticketID, TicketToContact.Definition())
+}
+
+// CompanyTickets gets the IDs of the tickets associated with a company.
+func (c *CRMAssociations) CompanyTickets(companyID int64) ([]int64, error) {
+	return c.GetAssociations(companyID, CompanyToTicket.Definition())
+}
+
+// TicketCompanies gets the IDs of the companies associated with a ticket.
+func (c *CRMAssociations) TicketCompanies(ticketID int64) ([]int64, error) {
+	return c.GetAssociations(ticketID, TicketToCompany.Definition())
+}
+
+// DealTickets gets the IDs of the tickets associated with a deal.
+func (c *CRMAssociations) DealTickets(dealID int64) ([]int64, error) {
+	return c.GetAssociations(dealID, DealToTicket.Definition())
+}
+
+// TicketDeals gets the IDs of the deals associated with a ticket.
+func (c *CRMAssociations) TicketDeals(ticketID int64) ([]int64, error) {
+	return c.GetAssociations(ticketID, TicketToDeal.Definition())
+}
+
+// ParentCompany gets the ID of the parent company of a company, in a slice that is empty when the company has no parent.
+func (c *CRMAssociations) ParentCompany(companyID int64) ([]int64, error) {
+	return c.GetAssociations(companyID, ChildCompanyToParentCompany.Definition())
+}
+
+// ChildCompanies gets the IDs of the child companies of a company.
+func (c *CRMAssociations) ChildCompanies(companyID int64) ([]int64, error) {
+	return c.GetAssociations(companyID, ParentCompanyToChildCompany.Definition())
+}
+
+// ContactEngagements gets the IDs of the engagements associated with a contact.
+func (c *CRMAssociations) ContactEngagements(contactID int64) ([]int64, error) {
+	return c.GetAssociations(contactID, ContactToEngagement.Definition())
+}
+
+// CompanyEngagements gets the IDs of the engagements associated with a company.
+func (c *CRMAssociations) CompanyEngagements(companyID int64) ([]int64, error) {
+	return c.GetAssociations(companyID, CompanyToEngagement.Definition())
+}
+
+// DealEngagements gets the IDs of the engagements associated with a deal.
+func (c *CRMAssociations) DealEngagements(dealID int64) ([]int64, error) {
+	return c.GetAssociations(dealID, DealToEngagement.Definition())
+}
+
+// TicketEngagements gets the IDs of the engagements associated with a ticket.
+func (c *CRMAssociations) TicketEngagements(ticketID int64) ([]int64, error) {
+	return c.GetAssociations(ticketID, TicketToEngagement.Definition())
+}
+
+// DealLineItems gets the IDs of the line items associated with a deal.
+func (c *CRMAssociations) DealLineItems(dealID int64) ([]int64, error) {
+	return c.GetAssociations(dealID, DealToLineItem.Definition())
+}
+
+// getAssociations gets all pages of associations from the endpoint. It works on a copy of the service so
+// the offset of one call doesn't leak into the next.
+func (c *CRMAssociations) getAssociations(endpoint string) ([]int64, error) {
+	svc := *c
+	c = &svc
+
+	url := buildURL(c, endpoint)
 
 	res, err := c.Call(url, http.MethodGet, nil)
 	if err != nil {
@@ -65,7 +187,7 @@ func (c *CRMAssociations) GetAssociationsForCRMObject(objectID string, definitio
 
 	if temp.HasMore {
 		for {
-			url := buildURL(c, fmt.Sprintf(associationsEndpoint, objectID, definitionID))
+			url := buildURL(c, endpoint)
 			res, err := c.Call(url, http.MethodGet, nil)
 			if err != nil {
 				return nil, err
@@ -88,6 +210,54 @@ func (c *CRMAssociations) GetAssociationsForCRMObject(objectID string, definitio
 	return associations, nil
 }
 
+// CreateAssociation associates two objects in HubSpot. Creating an association that already exists is not an error.
+func (c *CRMAssociations) CreateAssociation(association Association) error {
+	payload, err := association.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return c.putAssociations(createAssociationEndpoint, payload)
+}
+
+// CreateAssociations creates multiple associations between objects in a single call.
+func (c *CRMAssociations) CreateAssociations(associations []Association) error {
+	payload, err := json.Marshal(associations)
+	if err != nil {
+		return err
+	}
+
+	return c.putAssociations(createAssociationsEndpoint, payload)
+}
+
+// DeleteAssociation removes the association between two objects in HubSpot.
+func (c *CRMAssociations) DeleteAssociation(association Association) error {
+	payload, err := association.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return c.putAssociations(deleteAssociationEndpoint, payload)
+}
+
+// DeleteAssociations removes multiple associations between objects in a single call.
+func (c *CRMAssociations) DeleteAssociations(associations []Association) error {
+	payload, err := json.Marshal(associations)
+	if err != nil {
+		return err
+	}
+
+	return c.putAssociations(deleteAssociationsEndpoint, payload)
+}
+
+// putAssociations sends the payload to one of the endpoints that change associations.
+func (c *CRMAssociations) putAssociations(endpoint string, payload []byte) error {
+	url := fmt.Sprintf("%s?hapikey=%s", endpoint, c.APIKey)
+
+	_, err := c.Call(url, http.MethodPut, payload)
+	return err
+}
+
 // Construct the proper URL to call
 func buildURL(c *CRMAssociations, u string) string {
 	url := ""
@@ -1,7 +1,11 @@
 // Package deals covers the Deals API which has been exposed to allow for easy integration with the HubSpot CRM objects.
 package deals
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/retgits/hubspot/client"
+)
 
 // Associations is a struct generated from the HubSpot API
 type Associations struct {
@@ -13,13 +17,13 @@ type Associations struct {
 
 // Deal is a struct generated from the HubSpot API
 type Deal struct {
-	PortalID     int64               `json:"portalId"`
-	DealID       int64               `json:"dealId"`
-	IsDeleted    bool                `json:"isDeleted"`
-	Associations Associations        `json:"associations"`
-	Properties   map[string]Property `json:"properties"`
-	Imports      []interface{}       `json:"imports"`
-	StateChanges []interface{}       `json:"stateChanges"`
+	PortalID     int64                           `json:"portalId"`
+	DealID       int64                           `json:"dealId"`
+	IsDeleted    bool                            `json:"isDeleted"`
+	Associations Associations                    `json:"associations"`
+	Properties   map[string]client.PropertyValue `json:"properties"`
+	Imports      []interface{}                   `json:"imports"`
+	StateChanges []interface{}                   `json:"stateChanges"`
 }
 
 // Properties is a struct generated from the HubSpot API
@@ -27,7 +31,7 @@ type Properties struct {
 	Properties []Property `json:"properties"`
 }
 
-// Property is a struct generated from the HubSpot API
+// Property is a struct used to set the value of a deal property
 type Property struct {
 	Name      string    `json:"name,omitempty"`
 	Value     string    `json:"value"`
@@ -47,13 +51,13 @@ type RecentDeals struct {
 
 // Result is a struct generated from the HubSpot API
 type Result struct {
-	PortalID     int64               `json:"portalId"`
-	DealID       int64               `json:"dealId"`
-	IsDeleted    bool                `json:"isDeleted"`
-	Associations Associations        `json:"associations"`
-	Properties   map[string]Property `json:"properties"`
-	Imports      []interface{}       `json:"imports"`
-	StateChanges []interface{}       `json:"stateChanges"`
+	PortalID     int64                           `json:"portalId"`
+	DealID       int64                           `json:"dealId"`
+	IsDeleted    bool                            `json:"isDeleted"`
+	Associations Associations                    `json:"associations"`
+	Properties   map[string]client.PropertyValue `json:"properties"`
+	Imports      []interface{}                   `json:"imports"`
+	StateChanges []interface{}                   `json:"stateChanges"`
 }
 
 // Version is a struct generated from the HubSpot API
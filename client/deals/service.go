@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/retgits/hubspot/client/properties"
 )
 
 const (
@@ -22,14 +24,15 @@ const (
 // Deals contains the elements to communicate with the HubSpot Deals endpoints.
 type Deals struct {
 	*client.Client
-	Count  int64
-	OffSet int64
+	Count     int64
+	OffSet    int64
+	Validator *properties.Validator
 }
 
 // New creates a new instance of the Deals service with default settings.
 func New(c *client.Client) *Deals {
 	return &Deals{
-		c, defaultCount, defaultOffSet,
+		c, defaultCount, defaultOffSet, nil,
 	}
 }
 
@@ -40,6 +43,13 @@ func (d *Deals) WithOffSet(offset int64) *Deals {
 	return d
 }
 
+// WithValidator sets a Validator that checks property values before they are sent to HubSpot, returning a Deals
+// pointer for chaining.
+func (d *Deals) WithValidator(v *properties.Validator) *Deals {
+	d.Validator = v
+	return d
+}
+
 // GetDeal returns an object representing the deal with the id :dealId associated with the specified account.
 func (d *Deals) GetDeal(dealID string) (Deal, error) {
 	url := buildURL(d, fmt.Sprintf(getDealEndpoint, dealID))
@@ -97,8 +107,15 @@ func (d *Deals) GetRecentlyModifiedDeals() ([]Result, error) {
 
 // UpdateDeal is to update an existing deal in HubSpot. This method lets you update the properties of a deal in HubSpot.
 // The map[string]string represents the new values for the contact, where the map key is the name of the property and the map
-// value is the new value
+// value is the new value. When a Validator is set, the values are checked first and a *properties.ValidationError
+// lists every invalid property.
 func (d *Deals) UpdateDeal(dealID string, props map[string]string) error {
+	if d.Validator != nil {
+		if err := d.Validator.Validate(crm.Deals, props); err != nil {
+			return err
+		}
+	}
+
 	url := buildURL(d, fmt.Sprintf(updateDealEndpoint, dealID))
 
 	properties := make([]Property, 0)
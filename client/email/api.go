@@ -0,0 +1,198 @@
+// Package email covers the transactional and marketing email APIs: sending single-send transactional emails,
+// managing SMTP API tokens and reading marketing emails with their statistics.
+package email
+
+import "encoding/json"
+
+// SingleSend is the payload sent to send a transactional email
+type SingleSend struct {
+	EmailID           int64      `json:"emailId"`
+	Message           Message    `json:"message"`
+	ContactProperties []Property `json:"contactProperties,omitempty"`
+	CustomProperties  []Property `json:"customProperties,omitempty"`
+}
+
+// Message contains the recipient and sender settings of a transactional email. Only To is required; the other
+// fields override the settings of the email.
+type Message struct {
+	To          string   `json:"to"`
+	From        string   `json:"from,omitempty"`
+	SendID      string   `json:"sendId,omitempty"`
+	ReplyTo     string   `json:"replyTo,omitempty"`
+	ReplyToList []string `json:"replyToList,omitempty"`
+	Cc          []string `json:"cc,omitempty"`
+	Bcc         []string `json:"bcc,omitempty"`
+}
+
+// Property is a property value used in a transactional email. Contact properties are also written to the
+// recipient's contact record, custom properties are only available in the email template.
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SendResult is the payload returned after sending a transactional email
+type SendResult struct {
+	SendResult string   `json:"sendResult"`
+	Message    string   `json:"message,omitempty"`
+	EventID    *EventID `json:"eventId,omitempty"`
+}
+
+// EventID identifies the SENT event of a transactional email
+type EventID struct {
+	ID      string `json:"id"`
+	Created int64  `json:"created"`
+}
+
+// HubSpotTokens is the payload returned after listing SMTP API tokens
+type HubSpotTokens struct {
+	Objects []Token `json:"objects"`
+}
+
+// Token is an SMTP API token, used as the credentials to send transactional emails over SMTP. The password is only
+// returned when the token is created or its password is reset.
+type Token struct {
+	UserName        string `json:"userName"`
+	Password        string `json:"password,omitempty"`
+	CampaignName    string `json:"campaignName"`
+	CreateContact   bool   `json:"createContact"`
+	EmailCampaignID int64  `json:"emailCampaignId"`
+	CreatedAt       int64  `json:"createdAt"`
+	CreatedBy       int64  `json:"createdBy"`
+}
+
+// TokenInput is the payload sent to create an SMTP API token
+type TokenInput struct {
+	CreateContact bool   `json:"createContact"`
+	CampaignName  string `json:"campaignName"`
+}
+
+// HubSpotMarketingEmails is the payload returned after listing marketing emails
+type HubSpotMarketingEmails struct {
+	Objects []MarketingEmail `json:"objects"`
+	Total   int64            `json:"total"`
+	Limit   int64            `json:"limit"`
+	Offset  int64            `json:"offset"`
+}
+
+// MarketingEmail is a marketing email with its aggregate statistics
+type MarketingEmail struct {
+	ID                  int64   `json:"id"`
+	Name                string  `json:"name"`
+	Subject             string  `json:"subject"`
+	State               string  `json:"state"`
+	FromName            string  `json:"fromName,omitempty"`
+	ReplyTo             string  `json:"replyTo,omitempty"`
+	CampaignName        string  `json:"campaignName,omitempty"`
+	AllEmailCampaignIDS []int64 `json:"allEmailCampaignIds"`
+	Created             int64   `json:"created"`
+	Updated             int64   `json:"updated"`
+	PublishDate         int64   `json:"publishDate"`
+	Stats               Stats   `json:"stats"`
+}
+
+// Stats are the aggregate statistics of a marketing email
+type Stats struct {
+	Counters Counters `json:"counters"`
+	Ratios   Ratios   `json:"ratios"`
+}
+
+// Counters are the number of recipients per email event type
+type Counters struct {
+	Sent         int64 `json:"sent"`
+	Delivered    int64 `json:"delivered"`
+	Open         int64 `json:"open"`
+	Click        int64 `json:"click"`
+	Bounce       int64 `json:"bounce"`
+	HardBounced  int64 `json:"hardbounced"`
+	SoftBounced  int64 `json:"softbounced"`
+	Unsubscribed int64 `json:"unsubscribed"`
+	SpamReport   int64 `json:"spamreport"`
+	Dropped      int64 `json:"dropped"`
+	Selected     int64 `json:"selected"`
+	Reply        int64 `json:"reply"`
+	Processed    int64 `json:"processed"`
+	Deferred     int64 `json:"deferred"`
+	StatusChange int64 `json:"statuschange"`
+	Suppressed   int64 `json:"suppressed"`
+	ContactsLost int64 `json:"contactslost"`
+	NotSent      int64 `json:"notsent"`
+	MtaDropped   int64 `json:"mtadropped"`
+	Forward      int64 `json:"forward"`
+	Print        int64 `json:"print"`
+	Pending      int64 `json:"pending"`
+}
+
+// Ratios are the percentages of recipients per email event type
+type Ratios struct {
+	DeliveredRatio    float64 `json:"deliveredratio"`
+	OpenRatio         float64 `json:"openratio"`
+	ClickRatio        float64 `json:"clickratio"`
+	ClickThroughRatio float64 `json:"clickthroughratio"`
+	BounceRatio       float64 `json:"bounceratio"`
+	HardBounceRatio   float64 `json:"hardbounceratio"`
+	SoftBounceRatio   float64 `json:"softbounceratio"`
+	UnsubscribedRatio float64 `json:"unsubscribedratio"`
+	SpamReportRatio   float64 `json:"spamreportratio"`
+	ContactsLostRatio float64 `json:"contactslostratio"`
+	NotSentRatio      float64 `json:"notsentratio"`
+	PendingRatio      float64 `json:"pendingratio"`
+	SuppressedRatio   float64 `json:"suppressedratio"`
+	ReplyRatio        float64 `json:"replyratio"`
+	ForwardRatio      float64 `json:"forwardratio,omitempty"`
+	PrintRatio        float64 `json:"printratio,omitempty"`
+}
+
+// Marshal takes a SingleSend struct and transforms it into a byte array
+func (r *SingleSend) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// Marshal takes a TokenInput struct and transforms it into a byte array
+func (r *TokenInput) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func newProperties(props map[string]string) []Property {
+	properties := make([]Property, 0)
+
+	for key, val := range props {
+		prop := Property{
+			Name:  key,
+			Value: val,
+		}
+		properties = append(properties, prop)
+	}
+
+	return properties
+}
+
+func unmarshalSendResult(data []byte) (SendResult, error) {
+	var r SendResult
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalHubSpotTokens(data []byte) (HubSpotTokens, error) {
+	var r HubSpotTokens
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalToken(data []byte) (Token, error) {
+	var r Token
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalHubSpotMarketingEmails(data []byte) (HubSpotMarketingEmails, error) {
+	var r HubSpotMarketingEmails
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalMarketingEmail(data []byte) (MarketingEmail, error) {
+	var r MarketingEmail
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,25 @@
+// Package email covers the transactional and marketing email APIs: sending single-send transactional emails,
+// managing SMTP API tokens and reading marketing emails with their statistics.
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleSend(t *testing.T) {
+	send := SingleSend{
+		EmailID:           42,
+		Message:           Message{To: "codey@example.com"},
+		ContactProperties: newProperties(map[string]string{"firstname": "Codey", "lastname": "Huang"}),
+		CustomProperties:  newProperties(nil),
+	}
+
+	assert.ElementsMatch(t, []Property{{Name: "firstname", Value: "Codey"}, {Name: "lastname", Value: "Huang"}}, send.ContactProperties)
+
+	send.ContactProperties = send.ContactProperties[:0]
+	payload, err := send.Marshal()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"emailId":42,"message":{"to":"codey@example.com"}}`, string(payload))
+}
@@ -0,0 +1,212 @@
+// Package email covers the transactional and marketing email APIs: sending single-send transactional emails,
+// managing SMTP API tokens and reading marketing emails with their statistics.
+package email
+
+import (
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	defaultLimit  int64 = 100
+	defaultOffSet int64 = 0
+	// singleSendEndpoint is the endpoint to send a transactional email
+	singleSendEndpoint = "email/public/v1/singleEmail/send"
+	// tokensEndpoint is the endpoint to list and create SMTP API tokens
+	tokensEndpoint = "email/public/v1/smtpapi/tokens"
+	// tokenEndpoint is the endpoint to delete an SMTP API token
+	tokenEndpoint = "email/public/v1/smtpapi/tokens/%s"
+	// passwordResetEndpoint is the endpoint to reset the password of an SMTP API token
+	passwordResetEndpoint = "email/public/v1/smtpapi/tokens/%s/password-reset"
+	// marketingEmailsEndpoint is the endpoint to list marketing emails with their statistics
+	marketingEmailsEndpoint = "marketing-emails/v1/emails/with-statistics"
+	// marketingEmailEndpoint is the endpoint to get a single marketing email with its statistics
+	marketingEmailEndpoint = "marketing-emails/v1/emails/with-statistics/%d"
+)
+
+// Email contains the elements to communicate with the HubSpot email endpoints.
+type Email struct {
+	*client.Client
+	Limit  int64
+	OffSet int64
+}
+
+// New creates a new instance of the Email service with default settings.
+func New(c *client.Client) *Email {
+	return &Email{
+		c, defaultLimit, defaultOffSet,
+	}
+}
+
+// WithLimit sets the number of marketing emails returned per page, returning an Email pointer for chaining.
+func (e *Email) WithLimit(limit int64) *Email {
+	e.Limit = limit
+	return e
+}
+
+// WithOffSet sets an offset value returning an Email pointer for
+// chaining.
+func (e *Email) WithOffSet(offset int64) *Email {
+	e.OffSet = offset
+	return e
+}
+
+// SendEmail sends the transactional email with the given ID. The contactProps are used in the email and written to
+// the recipient's contact record, the customProps are only used in the email. Both can be nil.
+func (e *Email) SendEmail(emailID int64, message Message, contactProps map[string]string, customProps map[string]string) (SendResult, error) {
+	url := buildURL(e, singleSendEndpoint, false)
+
+	send := SingleSend{
+		EmailID:           emailID,
+		Message:           message,
+		ContactProperties: newProperties(contactProps),
+		CustomProperties:  newProperties(customProps),
+	}
+
+	payload, err := send.Marshal()
+	if err != nil {
+		return SendResult{}, err
+	}
+
+	res, err := e.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return SendResult{}, err
+	}
+
+	return unmarshalSendResult(res)
+}
+
+// GetAllTokens gets all SMTP API tokens of the portal.
+func (e *Email) GetAllTokens() ([]Token, error) {
+	url := buildURL(e, tokensEndpoint, false)
+
+	res, err := e.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotTokens(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Objects, nil
+}
+
+// CreateToken creates an SMTP API token. Store the password of the returned token, as it can't be retrieved later.
+func (e *Email) CreateToken(campaignName string, createContact bool) (Token, error) {
+	url := buildURL(e, tokensEndpoint, false)
+
+	input := TokenInput{
+		CreateContact: createContact,
+		CampaignName:  campaignName,
+	}
+
+	payload, err := input.Marshal()
+	if err != nil {
+		return Token{}, err
+	}
+
+	res, err := e.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return Token{}, err
+	}
+
+	return unmarshalToken(res)
+}
+
+// ResetTokenPassword creates a new password for an SMTP API token.
+func (e *Email) ResetTokenPassword(userName string) (Token, error) {
+	url := buildURL(e, fmt.Sprintf(passwordResetEndpoint, neturl.PathEscape(userName)), false)
+
+	res, err := e.Call(url, http.MethodPost, nil)
+	if err != nil {
+		return Token{}, err
+	}
+
+	return unmarshalToken(res)
+}
+
+// DeleteToken deletes an SMTP API token.
+func (e *Email) DeleteToken(userName string) error {
+	url := buildURL(e, fmt.Sprintf(tokenEndpoint, neturl.PathEscape(userName)), false)
+
+	_, err := e.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// GetAllMarketingEmails gets all marketing emails of the portal with their aggregate statistics, starting at the
+// offset of the service. The offset of the service itself isn't changed.
+func (e *Email) GetAllMarketingEmails() ([]MarketingEmail, error) {
+	return allMarketingEmails(e.OffSet, func(offset int64) (HubSpotMarketingEmails, error) {
+		svc := *e
+		return svc.WithOffSet(offset).getMarketingEmails()
+	})
+}
+
+// getMarketingEmails gets a single page of marketing emails at the offset of the service.
+func (e *Email) getMarketingEmails() (HubSpotMarketingEmails, error) {
+	url := buildURL(e, marketingEmailsEndpoint, true)
+
+	res, err := e.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return HubSpotMarketingEmails{}, err
+	}
+
+	return unmarshalHubSpotMarketingEmails(res)
+}
+
+// GetMarketingEmail gets a single marketing email with its aggregate statistics.
+func (e *Email) GetMarketingEmail(emailID int64) (MarketingEmail, error) {
+	url := buildURL(e, fmt.Sprintf(marketingEmailEndpoint, emailID), false)
+
+	res, err := e.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return MarketingEmail{}, err
+	}
+
+	return unmarshalMarketingEmail(res)
+}
+
+// allMarketingEmails fetches pages of marketing emails, starting at offset, until the total HubSpot reports is
+// reached or a page comes back empty.
+func allMarketingEmails(offset int64, fetch func(offset int64) (HubSpotMarketingEmails, error)) ([]MarketingEmail, error) {
+	emails := make([]MarketingEmail, 0)
+
+	for {
+		page, err := fetch(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, page.Objects...)
+		offset += int64(len(page.Objects))
+
+		if len(page.Objects) == 0 || offset >= page.Total {
+			return emails, nil
+		}
+	}
+}
+
+// Construct the proper URL to call
+func buildURL(e *Email, u string, paged bool) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, e.APIKey)
+
+	if !paged {
+		return url
+	}
+
+	if e.OffSet > 0 {
+		url = fmt.Sprintf("%s&offset=%d", url, e.OffSet)
+	}
+
+	if e.Limit > 0 {
+		url = fmt.Sprintf("%s&limit=%d", url, e.Limit)
+	}
+
+	return url
+}
@@ -0,0 +1,43 @@
+// Package email covers the transactional and marketing email APIs: sending single-send transactional emails,
+// managing SMTP API tokens and reading marketing emails with their statistics.
+package email
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllMarketingEmails(t *testing.T) {
+	offsets := make([]int64, 0)
+	emails, err := allMarketingEmails(10, func(offset int64) (HubSpotMarketingEmails, error) {
+		offsets = append(offsets, offset)
+		page := HubSpotMarketingEmails{Total: 15}
+		for i := offset; i < offset+3 && i < 15; i++ {
+			page.Objects = append(page.Objects, MarketingEmail{ID: i})
+		}
+		return page, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, emails, 5)
+	assert.Equal(t, []int64{10, 13}, offsets)
+
+	emails, err = allMarketingEmails(0, func(offset int64) (HubSpotMarketingEmails, error) {
+		return HubSpotMarketingEmails{Total: 15}, nil
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, emails)
+
+	_, err = allMarketingEmails(0, func(offset int64) (HubSpotMarketingEmails, error) {
+		return HubSpotMarketingEmails{}, fmt.Errorf("boom")
+	})
+	assert.Error(t, err)
+}
+
+func TestBuildURL(t *testing.T) {
+	e := New(client.NewClient().WithAPIKey("demo")).WithOffSet(100)
+	assert.Equal(t, "marketing-emails/v1/emails/with-statistics?hapikey=demo&offset=100&limit=100", buildURL(e, marketingEmailsEndpoint, true))
+	assert.Equal(t, "email/public/v1/singleEmail/send?hapikey=demo", buildURL(e, singleSendEndpoint, false))
+}
@@ -0,0 +1,328 @@
+// Package emailevents covers the email events API, which returns what happened to the emails sent from HubSpot
+// (sent, delivered, opened, clicked, bounced and more), and the email campaigns those events belong to.
+package emailevents
+
+import (
+	"encoding/json"
+
+	"github.com/retgits/hubspot/client/email"
+)
+
+const (
+	// TypeSent is the event type for emails sent to the email service provider
+	TypeSent = "SENT"
+	// TypeDropped is the event type for emails that were not sent
+	TypeDropped = "DROPPED"
+	// TypeProcessed is the event type for emails received by the email service provider
+	TypeProcessed = "PROCESSED"
+	// TypeDelivered is the event type for emails accepted by the recipient's mail server
+	TypeDelivered = "DELIVERED"
+	// TypeDeferred is the event type for emails the recipient's mail server temporarily rejected
+	TypeDeferred = "DEFERRED"
+	// TypeBounce is the event type for emails the recipient's mail server rejected
+	TypeBounce = "BOUNCE"
+	// TypeOpen is the event type for emails opened by the recipient
+	TypeOpen = "OPEN"
+	// TypeClick is the event type for links in an email clicked by the recipient
+	TypeClick = "CLICK"
+	// TypeStatusChange is the event type for changes to the recipient's subscriptions, including unsubscribes
+	TypeStatusChange = "STATUSCHANGE"
+	// TypeSpamReport is the event type for emails the recipient marked as spam
+	TypeSpamReport = "SPAMREPORT"
+	// TypeSuppressed is the event type for emails that were not sent to a suppressed recipient
+	TypeSuppressed = "SUPPRESSED"
+)
+
+// HubSpotEvents is the payload returned after listing email events
+type HubSpotEvents struct {
+	HasMore bool    `json:"hasMore"`
+	Offset  string  `json:"offset"`
+	Events  []Event `json:"events"`
+}
+
+// Event is implemented by the type-specific email events in this package.
+type Event interface {
+	// EventType returns the type of the email event.
+	EventType() string
+	// Details returns the fields all email events share.
+	Details() Common
+}
+
+// Common contains the fields all email events share
+type Common struct {
+	ID              string   `json:"id"`
+	Created         int64    `json:"created"`
+	Type            string   `json:"type"`
+	Recipient       string   `json:"recipient"`
+	PortalID        int64    `json:"portalId"`
+	AppID           int64    `json:"appId"`
+	AppName         string   `json:"appName"`
+	EmailCampaignID int64    `json:"emailCampaignId"`
+	SentBy          *EventID `json:"sentBy,omitempty"`
+	ObsoletedBy     *EventID `json:"obsoletedBy,omitempty"`
+	CausedBy        *EventID `json:"causedBy,omitempty"`
+	FilteredEvent   bool     `json:"filteredEvent,omitempty"`
+}
+
+// EventType returns the type of the event
+func (c Common) EventType() string { return c.Type }
+
+// Details returns the fields all email events share
+func (c Common) Details() Common { return c }
+
+// EventID references another email event
+type EventID struct {
+	ID      string `json:"id"`
+	Created int64  `json:"created"`
+}
+
+// Location is the approximate location of the recipient when opening an email or clicking a link
+type Location struct {
+	City    string `json:"city"`
+	State   string `json:"state"`
+	Country string `json:"country"`
+}
+
+// Browser is the browser used to open an email or click a link
+type Browser struct {
+	Name        string   `json:"name"`
+	Family      string   `json:"family"`
+	Producer    string   `json:"producer"`
+	ProducerURL string   `json:"producerUrl"`
+	Type        string   `json:"type"`
+	URL         string   `json:"url"`
+	Version     []string `json:"version"`
+}
+
+// SentEvent is a SENT email event
+type SentEvent struct {
+	Common
+	Subject string   `json:"subject"`
+	From    string   `json:"from"`
+	ReplyTo []string `json:"replyTo"`
+	Cc      []string `json:"cc"`
+	Bcc     []string `json:"bcc"`
+}
+
+// DroppedEvent is a DROPPED email event
+type DroppedEvent struct {
+	Common
+	Subject     string   `json:"subject"`
+	From        string   `json:"from"`
+	ReplyTo     []string `json:"replyTo"`
+	Cc          []string `json:"cc"`
+	Bcc         []string `json:"bcc"`
+	DropReason  string   `json:"dropReason"`
+	DropMessage string   `json:"dropMessage"`
+}
+
+// DeliveredEvent is a DELIVERED email event
+type DeliveredEvent struct {
+	Common
+	Response string `json:"response"`
+	SMTPID   string `json:"smtpId"`
+}
+
+// DeferredEvent is a DEFERRED email event
+type DeferredEvent struct {
+	Common
+	Response string `json:"response"`
+	Attempt  int64  `json:"attempt"`
+}
+
+// BounceEvent is a BOUNCE email event
+type BounceEvent struct {
+	Common
+	Category string `json:"category"`
+	Response string `json:"response"`
+	Status   string `json:"status"`
+}
+
+// OpenEvent is an OPEN email event
+type OpenEvent struct {
+	Common
+	UserAgent  string   `json:"userAgent"`
+	Browser    Browser  `json:"browser"`
+	Location   Location `json:"location"`
+	IPAddress  string   `json:"ipAddress,omitempty"`
+	DeviceType string   `json:"deviceType"`
+	Duration   int64    `json:"duration"`
+}
+
+// ClickEvent is a CLICK email event
+type ClickEvent struct {
+	Common
+	URL        string   `json:"url"`
+	Referer    string   `json:"referer"`
+	UserAgent  string   `json:"userAgent"`
+	Browser    Browser  `json:"browser"`
+	Location   Location `json:"location"`
+	IPAddress  string   `json:"ipAddress,omitempty"`
+	DeviceType string   `json:"deviceType"`
+}
+
+// SpamReportEvent is a SPAMREPORT email event
+type SpamReportEvent struct {
+	Common
+	UserAgent string `json:"userAgent"`
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// StatusChangeEvent is a STATUSCHANGE email event. Unsubscribes are reported as status changes, use
+// Unsubscribed to find them.
+type StatusChangeEvent struct {
+	Common
+	Source                   string         `json:"source"`
+	RequestedBy              string         `json:"requestedBy"`
+	PortalSubscriptionStatus string         `json:"portalSubscriptionStatus"`
+	Subscriptions            []Subscription `json:"subscriptions"`
+	Bounced                  bool           `json:"bounced"`
+}
+
+// Subscription is the new status of a single subscription type in a STATUSCHANGE event
+type Subscription struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+// Unsubscribed returns true if the recipient unsubscribed from all email or from any subscription type
+func (e *StatusChangeEvent) Unsubscribed() bool {
+	if e.PortalSubscriptionStatus == "UNSUBSCRIBED" {
+		return true
+	}
+
+	for _, s := range e.Subscriptions {
+		if s.Status == "UNSUBSCRIBED" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RawEvent keeps the email events that don't have a dedicated struct in this package, such as PROCESSED and
+// SUPPRESSED events
+type RawEvent struct {
+	Common
+	Raw json.RawMessage
+}
+
+// MarshalJSON writes the event back as it was received
+func (e *RawEvent) MarshalJSON() ([]byte, error) {
+	if len(e.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return e.Raw, nil
+}
+
+// UnmarshalJSON decodes the events and picks the event struct that matches the type of each event
+func (r *HubSpotEvents) UnmarshalJSON(data []byte) error {
+	temp := struct {
+		HasMore bool              `json:"hasMore"`
+		Offset  string            `json:"offset"`
+		Events  []json.RawMessage `json:"events"`
+	}{}
+
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	r.HasMore = temp.HasMore
+	r.Offset = temp.Offset
+	r.Events = make([]Event, 0, len(temp.Events))
+
+	for _, raw := range temp.Events {
+		event, err := unmarshalEvent(raw)
+		if err != nil {
+			return err
+		}
+		r.Events = append(r.Events, event)
+	}
+
+	return nil
+}
+
+// HubSpotCampaignIDs is the payload returned after listing email campaign IDs
+type HubSpotCampaignIDs struct {
+	HasMore   bool         `json:"hasMore"`
+	Offset    string       `json:"offset"`
+	Campaigns []CampaignID `json:"campaigns"`
+}
+
+// CampaignID identifies an email campaign. Campaigns sent by an app other than the marketing email tool
+// need the AppID to be read.
+type CampaignID struct {
+	ID      int64  `json:"id"`
+	AppID   int64  `json:"appId"`
+	AppName string `json:"appName"`
+}
+
+// Campaign is an email campaign with the number of recipients per event type
+type Campaign struct {
+	ID                       int64          `json:"id"`
+	AppID                    int64          `json:"appId"`
+	AppName                  string         `json:"appName"`
+	ContentID                int64          `json:"contentId"`
+	Name                     string         `json:"name"`
+	Subject                  string         `json:"subject"`
+	Type                     string         `json:"type"`
+	SubType                  string         `json:"subType"`
+	NumIncluded              int64          `json:"numIncluded"`
+	NumQueued                int64          `json:"numQueued"`
+	LastProcessingStartedAt  int64          `json:"lastProcessingStartedAt"`
+	LastProcessingFinishedAt int64          `json:"lastProcessingFinishedAt"`
+	Counters                 email.Counters `json:"counters"`
+}
+
+func unmarshalEvent(data []byte) (Event, error) {
+	var common Common
+	if err := json.Unmarshal(data, &common); err != nil {
+		return nil, err
+	}
+
+	var e Event
+
+	switch common.Type {
+	case TypeSent:
+		e = &SentEvent{}
+	case TypeDropped:
+		e = &DroppedEvent{}
+	case TypeDelivered:
+		e = &DeliveredEvent{}
+	case TypeDeferred:
+		e = &DeferredEvent{}
+	case TypeBounce:
+		e = &BounceEvent{}
+	case TypeOpen:
+		e = &OpenEvent{}
+	case TypeClick:
+		e = &ClickEvent{}
+	case TypeSpamReport:
+		e = &SpamReportEvent{}
+	case TypeStatusChange:
+		e = &StatusChangeEvent{}
+	default:
+		return &RawEvent{Common: common, Raw: data}, nil
+	}
+
+	err := json.Unmarshal(data, e)
+	return e, err
+}
+
+func unmarshalHubSpotEvents(data []byte) (HubSpotEvents, error) {
+	var r HubSpotEvents
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalHubSpotCampaignIDs(data []byte) (HubSpotCampaignIDs, error) {
+	var r HubSpotCampaignIDs
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalCampaign(data []byte) (Campaign, error) {
+	var r Campaign
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,83 @@
+// Package emailevents covers the email events API, which returns what happened to the emails sent from HubSpot
+// (sent, delivered, opened, clicked, bounced and more), and the email campaigns those events belong to.
+package emailevents
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalEvents(t *testing.T) {
+	data := []byte(`{"hasMore":true,"offset":"abc","events":[
+		{"id":"1","created":1500000000000,"type":"OPEN","recipient":"a@example.com","emailCampaignId":7,"deviceType":"COMPUTER","location":{"city":"Boston"}},
+		{"id":"2","created":1500000000001,"type":"CLICK","recipient":"a@example.com","url":"https://example.com"},
+		{"id":"3","type":"BOUNCE","category":"MAILBOX_FULL","status":"5.2.2"},
+		{"id":"4","type":"STATUSCHANGE","subscriptions":[{"id":10,"status":"UNSUBSCRIBED"}]},
+		{"id":"5","type":"PROCESSED","recipient":"b@example.com"}
+	]}`)
+
+	r, err := unmarshalHubSpotEvents(data)
+	assert.NoError(t, err)
+	assert.True(t, r.HasMore)
+	assert.Equal(t, "abc", r.Offset)
+	assert.Len(t, r.Events, 5)
+
+	open, ok := r.Events[0].(*OpenEvent)
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), open.EmailCampaignID)
+	assert.Equal(t, "Boston", open.Location.City)
+
+	assert.Equal(t, "https://example.com", r.Events[1].(*ClickEvent).URL)
+	assert.Equal(t, "MAILBOX_FULL", r.Events[2].(*BounceEvent).Category)
+	assert.True(t, r.Events[3].(*StatusChangeEvent).Unsubscribed())
+
+	raw, ok := r.Events[4].(*RawEvent)
+	assert.True(t, ok)
+	assert.Equal(t, TypeProcessed, raw.EventType())
+	assert.Equal(t, "b@example.com", raw.Details().Recipient)
+}
+
+func TestFilterQuery(t *testing.T) {
+	f := Filter{
+		Start:     time.Unix(1500000000, 0),
+		Recipient: "a+b@example.com",
+		EventType: TypeBounce,
+	}
+	assert.Equal(t, "&startTimestamp=1500000000000&recipient=a%2Bb%40example.com&eventType=BOUNCE", f.query())
+	assert.Equal(t, "", Filter{}.query())
+}
+
+func TestEventIterator(t *testing.T) {
+	pages := map[string]HubSpotEvents{
+		"":   {HasMore: true, Offset: "p2", Events: []Event{&SentEvent{}, &DeliveredEvent{}}},
+		"p2": {HasMore: false, Offset: "p3", Events: []Event{&OpenEvent{}}},
+	}
+	it := &EventIterator{fetch: func(offset string) (HubSpotEvents, error) {
+		return pages[offset], nil
+	}}
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 3, count)
+
+	it = &EventIterator{fetch: func(offset string) (HubSpotEvents, error) {
+		return HubSpotEvents{}, errors.New("boom")
+	}}
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "boom")
+}
+
+func TestBuildURL(t *testing.T) {
+	e := New(client.NewClient().WithAPIKey("demo")).WithLimit(10)
+	assert.Equal(t, "email/public/v1/events?hapikey=demo&limit=10", buildURL(e, eventsEndpoint, true, ""))
+	assert.Equal(t, "email/public/v1/events?hapikey=demo&offset=YWJj%2B%2F%3D&limit=10", buildURL(e, eventsEndpoint, true, "YWJj+/="))
+	assert.Equal(t, "email/public/v1/campaigns/7?hapikey=demo", buildURL(e, fmt.Sprintf(campaignEndpoint, 7), false, ""))
+}
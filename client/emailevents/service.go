@@ -0,0 +1,277 @@
+// Package emailevents covers the email events API, which returns what happened to the emails sent from HubSpot
+// (sent, delivered, opened, clicked, bounced and more), and the email campaigns those events belong to.
+package emailevents
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	defaultLimit int64 = 1000
+	// eventsEndpoint is the endpoint to list email events
+	eventsEndpoint = "email/public/v1/events"
+	// eventEndpoint is the endpoint to get a single email event
+	eventEndpoint = "email/public/v1/events/%d/%s"
+	// campaignIDsEndpoint is the endpoint to list the IDs of email campaigns
+	campaignIDsEndpoint = "email/public/v1/campaigns/by-id"
+	// campaignEndpoint is the endpoint to get a single email campaign
+	campaignEndpoint = "email/public/v1/campaigns/%d"
+)
+
+// EmailEvents contains the elements to communicate with the HubSpot email events endpoints.
+type EmailEvents struct {
+	*client.Client
+	Limit int64
+}
+
+// Filter selects the email events to return. Zero values are left out of the request.
+type Filter struct {
+	// Start and End limit the events to the ones created in that time range
+	Start time.Time
+	End   time.Time
+	// Recipient limits the events to the ones for a single email address
+	Recipient string
+	// CampaignID and AppID limit the events to a single email campaign
+	CampaignID int64
+	AppID      int64
+	// EventType limits the events to a single type, such as TypeOpen
+	EventType string
+	// ExcludeFilteredEvents leaves out events HubSpot filtered from reporting, such as opens by bots
+	ExcludeFilteredEvents bool
+}
+
+// New creates a new instance of the EmailEvents service with default settings.
+func New(c *client.Client) *EmailEvents {
+	return &EmailEvents{
+		c, defaultLimit,
+	}
+}
+
+// WithLimit sets the number of events or campaigns returned per page, returning an EmailEvents pointer for
+// chaining.
+func (e *EmailEvents) WithLimit(limit int64) *EmailEvents {
+	e.Limit = limit
+	return e
+}
+
+// GetEvents gets a single page of email events matching the filter. Pass the Offset of the returned page to get
+// the next one, as long as HasMore is true.
+func (e *EmailEvents) GetEvents(filter Filter, offset string) (HubSpotEvents, error) {
+	u := fmt.Sprintf("%s%s", buildURL(e, eventsEndpoint, true, offset), filter.query())
+
+	res, err := e.Call(u, http.MethodGet, nil)
+	if err != nil {
+		return HubSpotEvents{}, err
+	}
+
+	return unmarshalHubSpotEvents(res)
+}
+
+// GetAllEvents gets all email events matching the filter. Use Events for large time ranges, so the events don't
+// all have to be kept in memory.
+func (e *EmailEvents) GetAllEvents(filter Filter) ([]Event, error) {
+	events := make([]Event, 0)
+
+	it := e.Events(filter)
+	for it.Next() {
+		events = append(events, it.Event())
+	}
+
+	if it.Err() != nil {
+		return nil, it.Err()
+	}
+
+	return events, nil
+}
+
+// GetEvent gets a single email event, identified by the ID and creation timestamp of the event.
+func (e *EmailEvents) GetEvent(created int64, eventID string) (Event, error) {
+	u := buildURL(e, fmt.Sprintf(eventEndpoint, created, url.PathEscape(eventID)), false, "")
+
+	res, err := e.Call(u, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEvent(res)
+}
+
+// GetAllCampaignIDs gets the IDs of all email campaigns of the portal.
+func (e *EmailEvents) GetAllCampaignIDs() ([]CampaignID, error) {
+	campaigns := make([]CampaignID, 0)
+	offset := ""
+
+	for {
+		u := buildURL(e, campaignIDsEndpoint, true, offset)
+
+		res, err := e.Call(u, http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		temp, err := unmarshalHubSpotCampaignIDs(res)
+		if err != nil {
+			return nil, err
+		}
+
+		campaigns = append(campaigns, temp.Campaigns...)
+
+		if !temp.HasMore || temp.Offset == "" {
+			return campaigns, nil
+		}
+
+		offset = temp.Offset
+	}
+}
+
+// GetCampaign gets a single email campaign with the number of recipients per event type. The appID is only needed
+// for campaigns sent by an app other than the marketing email tool and can be 0 otherwise.
+func (e *EmailEvents) GetCampaign(campaignID int64, appID int64) (Campaign, error) {
+	u := buildURL(e, fmt.Sprintf(campaignEndpoint, campaignID), false, "")
+
+	if appID > 0 {
+		u = fmt.Sprintf("%s&appId=%d", u, appID)
+	}
+
+	res, err := e.Call(u, http.MethodGet, nil)
+	if err != nil {
+		return Campaign{}, err
+	}
+
+	return unmarshalCampaign(res)
+}
+
+// Events returns an EventIterator that reads all email events matching the filter, fetching pages from HubSpot
+// as needed. A typical loop looks like
+//
+//	it := svc.Events(filter)
+//	for it.Next() {
+//		switch event := it.Event().(type) {
+//		case *emailevents.OpenEvent:
+//			...
+//		}
+//	}
+//	if it.Err() != nil {
+//		...
+//	}
+func (e *EmailEvents) Events(filter Filter) *EventIterator {
+	return &EventIterator{
+		fetch: func(offset string) (HubSpotEvents, error) {
+			return e.GetEvents(filter, offset)
+		},
+	}
+}
+
+// EventIterator walks through email events, fetching pages from HubSpot as needed.
+type EventIterator struct {
+	fetch   func(offset string) (HubSpotEvents, error)
+	offset  string
+	page    []Event
+	current Event
+	done    bool
+	err     error
+}
+
+// Next advances the iterator to the next event. It returns false when there are no more events or when
+// an error occurred, which is available from Err.
+func (i *EventIterator) Next() bool {
+	for len(i.page) == 0 {
+		if i.done || i.err != nil {
+			return false
+		}
+
+		page, err := i.fetch(i.offset)
+		if err != nil {
+			i.err = err
+			return false
+		}
+
+		if !page.HasMore || page.Offset == "" {
+			i.done = true
+		}
+
+		i.offset = page.Offset
+		i.page = page.Events
+	}
+
+	i.current = i.page[0]
+	i.page = i.page[1:]
+
+	return true
+}
+
+// Event returns the event the iterator is at
+func (i *EventIterator) Event() Event {
+	return i.current
+}
+
+// Err returns the error that stopped the iterator, if any
+func (i *EventIterator) Err() error {
+	return i.err
+}
+
+// Offset returns the offset of the page after the one the iterator is reading. It can be passed to GetEvents
+// to resume from that page.
+func (i *EventIterator) Offset() string {
+	return i.offset
+}
+
+// query turns the filter into query string parameters
+func (f Filter) query() string {
+	query := ""
+
+	if !f.Start.IsZero() {
+		query = fmt.Sprintf("%s&startTimestamp=%d", query, f.Start.UnixNano()/int64(time.Millisecond))
+	}
+
+	if !f.End.IsZero() {
+		query = fmt.Sprintf("%s&endTimestamp=%d", query, f.End.UnixNano()/int64(time.Millisecond))
+	}
+
+	if f.Recipient != "" {
+		query = fmt.Sprintf("%s&recipient=%s", query, url.QueryEscape(f.Recipient))
+	}
+
+	if f.CampaignID > 0 {
+		query = fmt.Sprintf("%s&campaignId=%d", query, f.CampaignID)
+	}
+
+	if f.AppID > 0 {
+		query = fmt.Sprintf("%s&appId=%d", query, f.AppID)
+	}
+
+	if f.EventType != "" {
+		query = fmt.Sprintf("%s&eventType=%s", query, f.EventType)
+	}
+
+	if f.ExcludeFilteredEvents {
+		query = fmt.Sprintf("%s&excludeFilteredEvents=true", query)
+	}
+
+	return query
+}
+
+// Construct the proper URL to call
+func buildURL(e *EmailEvents, endpoint string, paged bool, offset string) string {
+	u := ""
+	u = fmt.Sprintf("%s?hapikey=%s", endpoint, e.APIKey)
+
+	if !paged {
+		return u
+	}
+
+	if offset != "" {
+		u = fmt.Sprintf("%s&offset=%s", u, url.QueryEscape(offset))
+	}
+
+	if e.Limit > 0 {
+		u = fmt.Sprintf("%s&limit=%d", u, e.Limit)
+	}
+
+	return u
+}
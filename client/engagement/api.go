@@ -4,48 +4,227 @@ package engagement
 
 import "encoding/json"
 
+const (
+	// TypeNote is the engagement type for notes
+	TypeNote = "NOTE"
+	// TypeEmail is the engagement type for emails sent from HubSpot
+	TypeEmail = "EMAIL"
+	// TypeIncomingEmail is the engagement type for replies to emails sent from HubSpot
+	TypeIncomingEmail = "INCOMING_EMAIL"
+	// TypeTask is the engagement type for tasks
+	TypeTask = "TASK"
+	// TypeMeeting is the engagement type for meetings
+	TypeMeeting = "MEETING"
+	// TypeCall is the engagement type for calls
+	TypeCall = "CALL"
+)
+
 // HubspotEngagement is a struct generated from the HubSpot API
 type HubspotEngagement struct {
-	Engagement   Engagement    `json:"engagement"`
-	Associations Associations  `json:"associations"`
-	Attachments  []interface{} `json:"attachments"`
-	Metadata     Metadata      `json:"metadata"`
+	Engagement   Engagement   `json:"engagement"`
+	Associations Associations `json:"associations"`
+	Attachments  []Attachment `json:"attachments"`
+	// Metadata holds one of the *Metadata types in this package, selected by Engagement.Type. Engagement
+	// types this package doesn't know about are kept as RawMetadata.
+	Metadata Metadata `json:"metadata"`
 }
 
 // Associations is a struct generated from the HubSpot API
 type Associations struct {
-	ContactIDS  []int64       `json:"contactIds"`
-	CompanyIDS  []interface{} `json:"companyIds"`
-	DealIDS     []interface{} `json:"dealIds"`
-	OwnerIDS    []interface{} `json:"ownerIds"`
-	WorkflowIDS []interface{} `json:"workflowIds"`
-	TicketIDS   []interface{} `json:"ticketIds"`
-	ContentIDS  []interface{} `json:"contentIds"`
-	QuoteIDS    []interface{} `json:"quoteIds"`
+	ContactIDS  []int64       `json:"contactIds,omitempty"`
+	CompanyIDS  []interface{} `json:"companyIds,omitempty"`
+	DealIDS     []interface{} `json:"dealIds,omitempty"`
+	OwnerIDS    []interface{} `json:"ownerIds,omitempty"`
+	WorkflowIDS []interface{} `json:"workflowIds,omitempty"`
+	TicketIDS   []interface{} `json:"ticketIds,omitempty"`
+	ContentIDS  []interface{} `json:"contentIds,omitempty"`
+	QuoteIDS    []interface{} `json:"quoteIds,omitempty"`
 }
 
 // Engagement is a struct generated from the HubSpot API
 type Engagement struct {
-	ID                   int64         `json:"id"`
-	PortalID             int64         `json:"portalId"`
+	ID                   int64         `json:"id,omitempty"`
+	PortalID             int64         `json:"portalId,omitempty"`
 	Active               bool          `json:"active"`
-	CreatedAt            int64         `json:"createdAt"`
-	LastUpdated          int64         `json:"lastUpdated"`
-	CreatedBy            int64         `json:"createdBy"`
-	ModifiedBy           int64         `json:"modifiedBy"`
+	CreatedAt            int64         `json:"createdAt,omitempty"`
+	LastUpdated          int64         `json:"lastUpdated,omitempty"`
+	CreatedBy            int64         `json:"createdBy,omitempty"`
+	ModifiedBy           int64         `json:"modifiedBy,omitempty"`
 	OwnerID              int64         `json:"ownerId"`
 	Type                 string        `json:"type"`
 	Timestamp            int64         `json:"timestamp"`
-	AllAccessibleTeamIDS []interface{} `json:"allAccessibleTeamIds"`
-	BodyPreview          string        `json:"bodyPreview"`
-	QueueMembershipIDS   []interface{} `json:"queueMembershipIds"`
+	AllAccessibleTeamIDS []interface{} `json:"allAccessibleTeamIds,omitempty"`
+	BodyPreview          string        `json:"bodyPreview,omitempty"`
+	QueueMembershipIDS   []interface{} `json:"queueMembershipIds,omitempty"`
+}
+
+// Attachment is a file from the file manager attached to an engagement. The ID is the ID of the file
+// as returned by the files package.
+type Attachment struct {
+	ID int64 `json:"id"`
+}
+
+// Metadata is implemented by the type-specific metadata of an engagement.
+type Metadata interface {
+	// EngagementType returns the engagement type the metadata belongs to.
+	EngagementType() string
 }
 
-// Metadata is a struct generated from the HubSpot API
-type Metadata struct {
+// NoteMetadata is the metadata of a NOTE engagement
+type NoteMetadata struct {
 	Body string `json:"body"`
 }
 
+// EngagementType returns TypeNote
+func (m *NoteMetadata) EngagementType() string { return TypeNote }
+
+// EmailAddress is a sender or recipient of an EMAIL or INCOMING_EMAIL engagement
+type EmailAddress struct {
+	Email     string `json:"email"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+}
+
+// EmailMetadata is the metadata of an EMAIL engagement
+type EmailMetadata struct {
+	From    EmailAddress   `json:"from"`
+	To      []EmailAddress `json:"to"`
+	Cc      []EmailAddress `json:"cc"`
+	Bcc     []EmailAddress `json:"bcc"`
+	Subject string         `json:"subject"`
+	HTML    string         `json:"html"`
+	Text    string         `json:"text"`
+}
+
+// EngagementType returns TypeEmail
+func (m *EmailMetadata) EngagementType() string { return TypeEmail }
+
+// IncomingEmailMetadata is the metadata of an INCOMING_EMAIL engagement, which has the same shape as
+// the metadata of an EMAIL engagement
+type IncomingEmailMetadata struct {
+	EmailMetadata
+}
+
+// EngagementType returns TypeIncomingEmail
+func (m *IncomingEmailMetadata) EngagementType() string { return TypeIncomingEmail }
+
+// TaskMetadata is the metadata of a TASK engagement
+type TaskMetadata struct {
+	Body          string  `json:"body"`
+	Subject       string  `json:"subject"`
+	Status        string  `json:"status"`
+	ForObjectType string  `json:"forObjectType"`
+	TaskType      string  `json:"taskType,omitempty"`
+	Priority      string  `json:"priority,omitempty"`
+	Reminders     []int64 `json:"reminders,omitempty"`
+}
+
+// EngagementType returns TypeTask
+func (m *TaskMetadata) EngagementType() string { return TypeTask }
+
+// MeetingMetadata is the metadata of a MEETING engagement
+type MeetingMetadata struct {
+	Body                 string `json:"body"`
+	Title                string `json:"title"`
+	StartTime            int64  `json:"startTime"`
+	EndTime              int64  `json:"endTime"`
+	InternalMeetingNotes string `json:"internalMeetingNotes,omitempty"`
+	MeetingOutcome       string `json:"meetingOutcome,omitempty"`
+}
+
+// EngagementType returns TypeMeeting
+func (m *MeetingMetadata) EngagementType() string { return TypeMeeting }
+
+// CallMetadata is the metadata of a CALL engagement
+type CallMetadata struct {
+	ToNumber             string `json:"toNumber"`
+	FromNumber           string `json:"fromNumber"`
+	Status               string `json:"status"`
+	ExternalID           string `json:"externalId,omitempty"`
+	ExternalAccountID    string `json:"externalAccountId,omitempty"`
+	DurationMilliseconds int64  `json:"durationMilliseconds"`
+	RecordingURL         string `json:"recordingUrl,omitempty"`
+	Body                 string `json:"body"`
+	Disposition          string `json:"disposition,omitempty"`
+	Title                string `json:"title,omitempty"`
+}
+
+// EngagementType returns TypeCall
+func (m *CallMetadata) EngagementType() string { return TypeCall }
+
+// RawMetadata keeps the metadata of engagement types that don't have a dedicated struct in this package
+type RawMetadata struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// EngagementType returns the type of the engagement the metadata was read from
+func (m *RawMetadata) EngagementType() string { return m.Type }
+
+// MarshalJSON writes the metadata back as it was received
+func (m *RawMetadata) MarshalJSON() ([]byte, error) {
+	if len(m.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return m.Raw, nil
+}
+
+// UnmarshalJSON decodes the engagement and picks the metadata struct that matches the engagement type
+func (r *HubspotEngagement) UnmarshalJSON(data []byte) error {
+	type alias HubspotEngagement
+	temp := struct {
+		*alias
+		Metadata json.RawMessage `json:"metadata"`
+	}{
+		alias: (*alias)(r),
+	}
+
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	metadata, err := unmarshalMetadata(r.Engagement.Type, temp.Metadata)
+	if err != nil {
+		return err
+	}
+
+	r.Metadata = metadata
+	return nil
+}
+
+// Marshal takes a HubspotEngagement struct and transforms it into a byte array
+func (r *HubspotEngagement) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func unmarshalMetadata(engagementType string, data json.RawMessage) (Metadata, error) {
+	var m Metadata
+
+	switch engagementType {
+	case TypeNote:
+		m = &NoteMetadata{}
+	case TypeEmail:
+		m = &EmailMetadata{}
+	case TypeIncomingEmail:
+		m = &IncomingEmailMetadata{}
+	case TypeTask:
+		m = &TaskMetadata{}
+	case TypeMeeting:
+		m = &MeetingMetadata{}
+	case TypeCall:
+		m = &CallMetadata{}
+	default:
+		return &RawMetadata{Type: engagementType, Raw: data}, nil
+	}
+
+	if len(data) == 0 || string(data) == "null" {
+		return m, nil
+	}
+
+	err := json.Unmarshal(data, m)
+	return m, err
+}
+
 func unmarshalHubspotEngagement(data []byte) (HubspotEngagement, error) {
 	var r HubspotEngagement
 	err := json.Unmarshal(data, &r)
@@ -0,0 +1,48 @@
+// Package engagement covers the engagements which are used to store data from CRM actions,
+// including notes, tasks, meetings, and calls.
+package engagement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalMetadata(t *testing.T) {
+	call := []byte(`{"engagement":{"id":1,"type":"CALL"},"metadata":{"toNumber":"+1555","durationMilliseconds":38000,"recordingUrl":"https://example.com/rec.mp3"}}`)
+	e, err := unmarshalHubspotEngagement(call)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), e.Engagement.ID)
+	meta, ok := e.Metadata.(*CallMetadata)
+	assert.True(t, ok)
+	assert.Equal(t, int64(38000), meta.DurationMilliseconds)
+	assert.Equal(t, "https://example.com/rec.mp3", meta.RecordingURL)
+
+	task := []byte(`{"engagement":{"type":"TASK"},"metadata":{"subject":"Follow up","status":"NOT_STARTED"}}`)
+	e, err = unmarshalHubspotEngagement(task)
+	assert.NoError(t, err)
+	assert.Equal(t, TypeTask, e.Metadata.EngagementType())
+	assert.Equal(t, "NOT_STARTED", e.Metadata.(*TaskMetadata).Status)
+
+	unknown := []byte(`{"engagement":{"type":"SMS"},"metadata":{"text":"hi"}}`)
+	e, err = unmarshalHubspotEngagement(unknown)
+	assert.NoError(t, err)
+	raw, ok := e.Metadata.(*RawMetadata)
+	assert.True(t, ok)
+	assert.Equal(t, "SMS", raw.EngagementType())
+	assert.JSONEq(t, `{"text":"hi"}`, string(raw.Raw))
+}
+
+func TestMarshalEngagement(t *testing.T) {
+	e := HubspotEngagement{
+		Engagement: Engagement{Active: false, Type: TypeNote, Timestamp: 1557964800000},
+		Metadata:   &NoteMetadata{Body: "Called back"},
+	}
+
+	payload, err := e.Marshal()
+	assert.NoError(t, err)
+	assert.Contains(t, string(payload), `"active":false`)
+	assert.Contains(t, string(payload), `"ownerId":0`)
+	assert.NotContains(t, string(payload), `"id"`)
+	assert.NotContains(t, string(payload), `"createdAt"`)
+}
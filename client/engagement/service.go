@@ -10,6 +10,8 @@ import (
 )
 
 const (
+	// createEngagementEndpoint is the endpoint to create an engagement
+	createEngagementEndpoint = "engagements/v1/engagements"
 	// engagementEndpoint is the endpoint to retrieve a single engagement
 	engagementEndpoint = "engagements/v1/engagements/%d"
 )
@@ -38,6 +40,29 @@ func (e *Engagements) GetEngagement(engagementID int64) (HubspotEngagement, erro
 	return unmarshalHubspotEngagement(res)
 }
 
+// CreateEngagement creates an engagement (a note, email, task, meeting or call) on objects in HubSpot. The
+// engagement type must match the type of the metadata. Files uploaded with the files package can be attached
+// by adding their IDs to the Attachments of the engagement.
+func (e *Engagements) CreateEngagement(engagement HubspotEngagement) (HubspotEngagement, error) {
+	url := url(e, createEngagementEndpoint)
+
+	if engagement.Metadata != nil && engagement.Engagement.Type == "" {
+		engagement.Engagement.Type = engagement.Metadata.EngagementType()
+	}
+
+	payload, err := engagement.Marshal()
+	if err != nil {
+		return HubspotEngagement{}, err
+	}
+
+	res, err := e.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return HubspotEngagement{}, err
+	}
+
+	return unmarshalHubspotEngagement(res)
+}
+
 // Construct the proper URL to call
 func url(e *Engagements, u string) string {
 	url := ""
@@ -0,0 +1,74 @@
+// Package files covers the File Manager API, which lets you upload, list, retrieve and delete the files
+// stored in the HubSpot file manager. Uploaded files can be attached to engagements.
+package files
+
+import "encoding/json"
+
+// HubSpotFiles is the payload returned after listing or uploading files
+type HubSpotFiles struct {
+	Objects    []File `json:"objects"`
+	TotalCount int64  `json:"total_count"`
+	Limit      int64  `json:"limit"`
+	Offset     int64  `json:"offset"`
+}
+
+// File is a struct generated from the HubSpot API
+type File struct {
+	ID                  int64  `json:"id"`
+	PortalID            int64  `json:"portal_id"`
+	Name                string `json:"name"`
+	Size                int64  `json:"size"`
+	Height              int64  `json:"height,omitempty"`
+	Width               int64  `json:"width,omitempty"`
+	Encoding            string `json:"encoding,omitempty"`
+	Type                string `json:"type"`
+	Extension           string `json:"extension"`
+	CloudKey            string `json:"cloud_key"`
+	S3URL               string `json:"s3_url"`
+	FriendlyURL         string `json:"friendly_url"`
+	URL                 string `json:"url"`
+	AltKey              string `json:"alt_key"`
+	Meta                Meta   `json:"meta"`
+	Created             int64  `json:"created"`
+	Updated             int64  `json:"updated"`
+	DeletedAt           int64  `json:"deleted_at"`
+	FolderID            int64  `json:"folder_id"`
+	Hidden              bool   `json:"hidden"`
+	Archived            bool   `json:"archived"`
+	CDNPurgeEmbargoTime int64  `json:"cdn_purge_embargo_time,omitempty"`
+}
+
+// Meta is a struct generated from the HubSpot API
+type Meta struct {
+	AllowsAnonymousAccess bool `json:"allows_anonymous_access"`
+	Indexable             bool `json:"indexable"`
+}
+
+// UploadOptions are the options sent along with a file upload
+type UploadOptions struct {
+	// Access determines who can see the file. Allowed values are PUBLIC_INDEXABLE, PUBLIC_NOT_INDEXABLE and PRIVATE.
+	Access string `json:"access,omitempty"`
+	// Overwrite replaces an existing file with the same name in the same folder.
+	Overwrite bool `json:"overwrite"`
+	// DuplicateValidationStrategy is one of NONE, REJECT or RETURN_EXISTING.
+	DuplicateValidationStrategy string `json:"duplicateValidationStrategy,omitempty"`
+	// DuplicateValidationScope is one of ENTIRE_PORTAL or EXACT_FOLDER.
+	DuplicateValidationScope string `json:"duplicateValidationScope,omitempty"`
+}
+
+// Marshal takes an UploadOptions struct and transforms it into a byte array
+func (r *UploadOptions) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func unmarshalHubSpotFiles(data []byte) (HubSpotFiles, error) {
+	var r HubSpotFiles
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalFile(data []byte) (File, error) {
+	var r File
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,194 @@
+// Package files covers the File Manager API, which lets you upload, list, retrieve and delete the files
+// stored in the HubSpot file manager. Uploaded files can be attached to engagements.
+package files
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	defaultLimit  int64 = 100
+	defaultOffSet int64 = 0
+	// filesEndpoint is the endpoint to list and upload files
+	filesEndpoint = "filemanager/api/v2/files"
+	// fileEndpoint is the endpoint to get and delete a single file
+	fileEndpoint = "filemanager/api/v2/files/%d"
+)
+
+// Files contains the elements to communicate with the HubSpot File Manager endpoints.
+type Files struct {
+	*client.Client
+	Limit  int64
+	OffSet int64
+}
+
+// New creates a new instance of the Files service with default settings.
+func New(c *client.Client) *Files {
+	return &Files{
+		c, defaultLimit, defaultOffSet,
+	}
+}
+
+// WithLimit sets a limit value returning a Files pointer for
+// chaining.
+func (f *Files) WithLimit(limit int64) *Files {
+	f.Limit = limit
+	return f
+}
+
+// WithOffSet sets an offset value returning a Files pointer for
+// chaining.
+func (f *Files) WithOffSet(offset int64) *Files {
+	f.OffSet = offset
+	return f
+}
+
+// UploadFile uploads the content of r as a file called fileName into the folder at folderPath. The
+// uploaded file is returned, and its ID can be used to attach the file to an engagement.
+func (f *Files) UploadFile(fileName string, r io.Reader, folderPath string, opts UploadOptions) (File, error) {
+	url := buildURL(f, filesEndpoint, false)
+
+	contentType, body, err := uploadBody(fileName, r, folderPath, opts)
+	if err != nil {
+		return File{}, err
+	}
+
+	res, err := f.CallWithContentType(url, http.MethodPost, contentType, body)
+	if err != nil {
+		return File{}, err
+	}
+
+	temp, err := unmarshalHubSpotFiles(res)
+	if err != nil {
+		return File{}, err
+	}
+
+	if len(temp.Objects) == 0 {
+		return File{}, fmt.Errorf("no file returned after uploading %s", fileName)
+	}
+
+	return temp.Objects[0], nil
+}
+
+// GetAllFiles gets the metadata of all files in the file manager, starting at the offset of the service. The
+// offset of the service itself isn't changed.
+func (f *Files) GetAllFiles() ([]File, error) {
+	return allFiles(f.OffSet, func(offset int64) (HubSpotFiles, error) {
+		svc := *f
+		return svc.WithOffSet(offset).getFiles()
+	})
+}
+
+// getFiles gets a single page of files at the offset of the service.
+func (f *Files) getFiles() (HubSpotFiles, error) {
+	url := buildURL(f, filesEndpoint, true)
+
+	res, err := f.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return HubSpotFiles{}, err
+	}
+
+	return unmarshalHubSpotFiles(res)
+}
+
+// GetFile gets the metadata of a single file.
+func (f *Files) GetFile(fileID int64) (File, error) {
+	url := buildURL(f, fmt.Sprintf(fileEndpoint, fileID), false)
+
+	res, err := f.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return File{}, err
+	}
+
+	return unmarshalFile(res)
+}
+
+// DeleteFile marks a file as deleted in the file manager.
+func (f *Files) DeleteFile(fileID int64) error {
+	url := buildURL(f, fmt.Sprintf(fileEndpoint, fileID), false)
+
+	_, err := f.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// uploadBody builds the multipart body of a file upload and returns it with its content type.
+func uploadBody(fileName string, r io.Reader, folderPath string, opts UploadOptions) (string, []byte, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("files", fileName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if _, err := io.Copy(part, r); err != nil {
+		return "", nil, err
+	}
+
+	if folderPath != "" {
+		if err := writer.WriteField("folder_paths", folderPath); err != nil {
+			return "", nil, err
+		}
+	}
+
+	options, err := opts.Marshal()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := writer.WriteField("options", string(options)); err != nil {
+		return "", nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return writer.FormDataContentType(), body.Bytes(), nil
+}
+
+// allFiles fetches pages of files, starting at offset, until the total count HubSpot reports is reached or a
+// page comes back empty.
+func allFiles(offset int64, fetch func(offset int64) (HubSpotFiles, error)) ([]File, error) {
+	files := make([]File, 0)
+
+	for {
+		page, err := fetch(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, page.Objects...)
+		offset += int64(len(page.Objects))
+
+		if len(page.Objects) == 0 || offset >= page.TotalCount {
+			return files, nil
+		}
+	}
+}
+
+// Construct the proper URL to call
+func buildURL(f *Files, u string, paged bool) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, f.APIKey)
+
+	if !paged {
+		return url
+	}
+
+	if f.OffSet > 0 {
+		url = fmt.Sprintf("%s&offset=%d", url, f.OffSet)
+	}
+
+	if f.Limit > 0 {
+		url = fmt.Sprintf("%s&limit=%d", url, f.Limit)
+	}
+
+	return url
+}
@@ -0,0 +1,80 @@
+// Package files covers the File Manager API, which lets you upload, list, retrieve and delete the files
+// stored in the HubSpot file manager. Uploaded files can be attached to engagements.
+package files
+
+import (
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadBody(t *testing.T) {
+	contentType, body, err := uploadBody("notes.txt", strings.NewReader("hello"), "/docs", UploadOptions{Access: "PRIVATE"})
+	assert.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	form, err := multipart.NewReader(strings.NewReader(string(body)), params["boundary"]).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/docs"}, form.Value["folder_paths"])
+	assert.Equal(t, []string{`{"access":"PRIVATE","overwrite":false}`}, form.Value["options"])
+
+	if assert.Len(t, form.File["files"], 1) {
+		assert.Equal(t, "notes.txt", form.File["files"][0].Filename)
+		file, err := form.File["files"][0].Open()
+		assert.NoError(t, err)
+		content, err := ioutil.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(content))
+	}
+
+	_, body, err = uploadBody("notes.txt", strings.NewReader("hello"), "", UploadOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(body), "folder_paths")
+}
+
+func TestAllFiles(t *testing.T) {
+	offsets := make([]int64, 0)
+	files, err := allFiles(0, func(offset int64) (HubSpotFiles, error) {
+		offsets = append(offsets, offset)
+		page := HubSpotFiles{TotalCount: 5}
+		for i := offset; i < offset+2 && i < 5; i++ {
+			page.Objects = append(page.Objects, File{ID: i})
+		}
+		return page, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, files, 5)
+	assert.Equal(t, []int64{0, 2, 4}, offsets)
+
+	offsets = offsets[:0]
+	files, err = allFiles(0, func(offset int64) (HubSpotFiles, error) {
+		offsets = append(offsets, offset)
+		if offset > 0 {
+			return HubSpotFiles{TotalCount: 10}, nil
+		}
+		return HubSpotFiles{TotalCount: 10, Objects: []File{{ID: 1}, {ID: 2}}}, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, []int64{0, 2}, offsets)
+
+	_, err = allFiles(0, func(offset int64) (HubSpotFiles, error) {
+		return HubSpotFiles{}, fmt.Errorf("boom")
+	})
+	assert.Error(t, err)
+}
+
+func TestBuildURL(t *testing.T) {
+	f := New(client.NewClient().WithAPIKey("demo")).WithOffSet(20).WithLimit(10)
+	assert.Equal(t, "filemanager/api/v2/files?hapikey=demo&offset=20&limit=10", buildURL(f, filesEndpoint, true))
+	assert.Equal(t, "filemanager/api/v2/files/1?hapikey=demo", buildURL(f, fmt.Sprintf(fileEndpoint, 1), false))
+}
@@ -0,0 +1,191 @@
+// Package forms covers the Forms API, which is used to manage HubSpot forms, read the data submitted through them
+// and submit data to a form from your own backend.
+package forms
+
+import (
+	"encoding/json"
+
+	"github.com/retgits/hubspot/client/contacts"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+// Form is a struct generated from the HubSpot API
+type Form struct {
+	GUID                    string       `json:"guid,omitempty"`
+	Name                    string       `json:"name,omitempty"`
+	Action                  string       `json:"action,omitempty"`
+	Method                  string       `json:"method,omitempty"`
+	CSSClass                string       `json:"cssClass,omitempty"`
+	Redirect                string       `json:"redirect,omitempty"`
+	SubmitText              string       `json:"submitText,omitempty"`
+	NotifyRecipients        string       `json:"notifyRecipients,omitempty"`
+	InlineMessage           string       `json:"inlineMessage,omitempty"`
+	LeadNurturingCampaignID string       `json:"leadNurturingCampaignId,omitempty"`
+	FormFieldGroups         []FieldGroup `json:"formFieldGroups,omitempty"`
+	MetaData                []MetaData   `json:"metaData,omitempty"`
+	FormType                string       `json:"formType,omitempty"`
+	CaptchaEnabled          bool         `json:"captchaEnabled,omitempty"`
+	CampaignGUID            string       `json:"campaignGuid,omitempty"`
+	IgnoreCurrentValues     bool         `json:"ignoreCurrentValues,omitempty"`
+	Deletable               bool         `json:"deletable,omitempty"`
+	Cloneable               bool         `json:"cloneable,omitempty"`
+	Editable                bool         `json:"editable,omitempty"`
+	CreatedAt               int64        `json:"createdAt,omitempty"`
+	UpdatedAt               int64        `json:"updatedAt,omitempty"`
+}
+
+// FieldGroup is a row of fields on a form
+type FieldGroup struct {
+	Fields       []Field   `json:"fields"`
+	Default      bool      `json:"default"`
+	IsSmartGroup bool      `json:"isSmartGroup"`
+	RichText     *RichText `json:"richText,omitempty"`
+}
+
+// RichText is the text shown in a field group instead of fields
+type RichText struct {
+	Content string `json:"content"`
+}
+
+// Field is a single field on a form
+type Field struct {
+	Name            string      `json:"name"`
+	Label           string      `json:"label"`
+	Type            string      `json:"type"`
+	FieldType       string      `json:"fieldType"`
+	Description     string      `json:"description,omitempty"`
+	GroupName       string      `json:"groupName,omitempty"`
+	DisplayOrder    int64       `json:"displayOrder"`
+	Required        bool        `json:"required"`
+	Enabled         bool        `json:"enabled"`
+	Hidden          bool        `json:"hidden"`
+	DefaultValue    string      `json:"defaultValue,omitempty"`
+	Placeholder     string      `json:"placeholder,omitempty"`
+	UnselectedLabel string      `json:"unselectedLabel,omitempty"`
+	IsSmartField    bool        `json:"isSmartField"`
+	LabelHidden     bool        `json:"labelHidden"`
+	SelectedOptions []string    `json:"selectedOptions,omitempty"`
+	Options         []Option    `json:"options,omitempty"`
+	Validation      *Validation `json:"validation,omitempty"`
+}
+
+// Option is a possible value of a select, radio or checkbox field
+type Option struct {
+	Label        string `json:"label"`
+	Value        string `json:"value"`
+	DisplayOrder int64  `json:"displayOrder"`
+	Hidden       bool   `json:"hidden"`
+	Description  string `json:"description,omitempty"`
+	ReadOnly     bool   `json:"readOnly"`
+}
+
+// Validation contains the validation rules of a field
+type Validation struct {
+	Name                  string   `json:"name"`
+	Message               string   `json:"message"`
+	Data                  string   `json:"data"`
+	UseDefaultBlockList   bool     `json:"useDefaultBlockList"`
+	BlockedEmailAddresses []string `json:"blockedEmailAddresses,omitempty"`
+}
+
+// MetaData is a setting of a form
+type MetaData struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Submissions is a single page of submissions of a form
+type Submissions struct {
+	Results []Submission `json:"results"`
+	Paging  *crm.Paging  `json:"paging,omitempty"`
+}
+
+// Submission is a submission as returned by the form submissions endpoint
+type Submission struct {
+	SubmittedAt int64                `json:"submittedAt"`
+	Values      []contacts.FormValue `json:"values"`
+	PageURL     string               `json:"pageUrl"`
+}
+
+// FormSubmission returns the submission as the same type used for the form submissions of a contact
+func (s *Submission) FormSubmission(formGUID string) contacts.FormSubmission {
+	return contacts.FormSubmission{
+		Timestamp: s.SubmittedAt,
+		FormID:    formGUID,
+		PageURL:   s.PageURL,
+		Values:    s.Values,
+	}
+}
+
+// SubmitData is the data submitted to a form
+type SubmitData struct {
+	SubmittedAt         int64                `json:"submittedAt,omitempty"`
+	Fields              []contacts.FormValue `json:"fields"`
+	Context             *Context             `json:"context,omitempty"`
+	LegalConsentOptions *LegalConsent        `json:"legalConsentOptions,omitempty"`
+	SkipValidation      bool                 `json:"skipValidation,omitempty"`
+}
+
+// Context describes the page and visitor the data was submitted from. Hutk is the value of the hubspotutk cookie,
+// which links the submission to the visitor's page views.
+type Context struct {
+	Hutk      string `json:"hutk,omitempty"`
+	PageURI   string `json:"pageUri,omitempty"`
+	PageName  string `json:"pageName,omitempty"`
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// LegalConsent contains the consent given by the visitor when submitting the form
+type LegalConsent struct {
+	Consent *Consent `json:"consent,omitempty"`
+}
+
+// Consent is the consent to process data and to receive communication
+type Consent struct {
+	ConsentToProcess bool            `json:"consentToProcess"`
+	Text             string          `json:"text"`
+	Communications   []Communication `json:"communications,omitempty"`
+}
+
+// Communication is the consent to receive a type of subscription
+type Communication struct {
+	Value              bool   `json:"value"`
+	SubscriptionTypeID int64  `json:"subscriptionTypeId"`
+	Text               string `json:"text"`
+}
+
+// SubmitResult is the payload returned after submitting data to a form
+type SubmitResult struct {
+	InlineMessage string `json:"inlineMessage,omitempty"`
+	RedirectURI   string `json:"redirectUri,omitempty"`
+}
+
+func unmarshalForm(data []byte) (Form, error) {
+	var r Form
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalForms(data []byte) ([]Form, error) {
+	var r []Form
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalFields(data []byte) ([]Field, error) {
+	var r []Field
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalSubmissions(data []byte) (Submissions, error) {
+	var r Submissions
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalSubmitResult(data []byte) (SubmitResult, error) {
+	var r SubmitResult
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,16 @@
+// Package forms covers the Forms API, which is used to manage HubSpot forms, read the data submitted through them
+// and submit data to a form from your own backend.
+package forms
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormPartialUpdate(t *testing.T) {
+	payload, err := json.Marshal(Form{SubmitText: "Send"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"submitText":"Send"}`, string(payload))
+}
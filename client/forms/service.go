@@ -0,0 +1,185 @@
+// Package forms covers the Forms API, which is used to manage HubSpot forms, read the data submitted through them
+// and submit data to a form from your own backend.
+package forms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/contacts"
+)
+
+const (
+	defaultLimit int64 = 50
+	// formsEndpoint is the endpoint to list and create forms
+	formsEndpoint = "forms/v2/forms"
+	// formEndpoint is the endpoint to get and update a single form
+	formEndpoint = "forms/v2/forms/%s"
+	// fieldsEndpoint is the endpoint to get the fields of a form
+	fieldsEndpoint = "forms/v2/fields/%s"
+	// submissionsEndpoint is the endpoint to get the submissions of a form
+	submissionsEndpoint = "form-integrations/v1/submissions/forms/%s"
+	// submitEndpoint is the endpoint to submit data to a form, which is on the forms host instead of the API host
+	submitEndpoint = "submissions/v3/integration/submit/%d/%s"
+)
+
+// Forms contains the elements to communicate with the HubSpot Forms endpoints.
+type Forms struct {
+	*client.Client
+	Limit int64
+}
+
+// New creates a new instance of the Forms service with default settings.
+func New(c *client.Client) *Forms {
+	return &Forms{
+		c, defaultLimit,
+	}
+}
+
+// WithLimit sets the number of submissions returned per page, returning a Forms pointer for chaining.
+func (f *Forms) WithLimit(limit int64) *Forms {
+	f.Limit = limit
+	return f
+}
+
+// GetAllForms gets all forms in the portal.
+func (f *Forms) GetAllForms() ([]Form, error) {
+	url := buildURL(f, formsEndpoint)
+
+	res, err := f.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalForms(res)
+}
+
+// GetForm gets a single form by its GUID.
+func (f *Forms) GetForm(formGUID string) (Form, error) {
+	url := buildURL(f, fmt.Sprintf(formEndpoint, formGUID))
+
+	res, err := f.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Form{}, err
+	}
+
+	return unmarshalForm(res)
+}
+
+// CreateForm creates a form.
+func (f *Forms) CreateForm(form Form) (Form, error) {
+	url := buildURL(f, formsEndpoint)
+
+	return f.sendForm(url, form)
+}
+
+// UpdateForm updates the form with the given GUID. Only the fields of the form that are set are changed, so leave
+// FormFieldGroups empty to keep the fields of the form as they are.
+func (f *Forms) UpdateForm(formGUID string, form Form) (Form, error) {
+	url := buildURL(f, fmt.Sprintf(formEndpoint, formGUID))
+
+	return f.sendForm(url, form)
+}
+
+// GetFields gets the definitions of the fields of a form.
+func (f *Forms) GetFields(formGUID string) ([]Field, error) {
+	url := buildURL(f, fmt.Sprintf(fieldsEndpoint, formGUID))
+
+	res, err := f.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalFields(res)
+}
+
+// GetSubmissions gets all submissions of a form, newest first, following the paging cursor until the last page.
+// The submissions use the same type as the form submissions of a contact.
+func (f *Forms) GetSubmissions(formGUID string) ([]contacts.FormSubmission, error) {
+	after := ""
+	submissions := make([]contacts.FormSubmission, 0)
+
+	for {
+		url := submissionsURL(f, formGUID, after)
+
+		res, err := f.Call(url, http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		temp, err := unmarshalSubmissions(res)
+		if err != nil {
+			return nil, err
+		}
+
+		for idx := range temp.Results {
+			submissions = append(submissions, temp.Results[idx].FormSubmission(formGUID))
+		}
+
+		if temp.Paging == nil || temp.Paging.Next == nil || temp.Paging.Next.After == "" {
+			break
+		}
+		after = temp.Paging.Next.After
+	}
+
+	return submissions, nil
+}
+
+// SubmitForm submits data to a form, the same way a visitor submitting the form on a page would. This endpoint
+// doesn't need an API key.
+func (f *Forms) SubmitForm(portalID int64, formGUID string, data SubmitData) (SubmitResult, error) {
+	url := fmt.Sprintf(submitEndpoint, portalID, formGUID)
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+
+	res, err := f.CallForms(url, http.MethodPost, payload)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+
+	return unmarshalSubmitResult(res)
+}
+
+// sendForm marshals the form, sends it and unmarshals the form that HubSpot returns.
+func (f *Forms) sendForm(url string, form Form) (Form, error) {
+	payload, err := json.Marshal(form)
+	if err != nil {
+		return Form{}, err
+	}
+
+	res, err := f.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return Form{}, err
+	}
+
+	return unmarshalForm(res)
+}
+
+// submissionsURL constructs the URL of a page of submissions, starting after the paging cursor
+func submissionsURL(f *Forms, formGUID string, after string) string {
+	url := buildURL(f, fmt.Sprintf(submissionsEndpoint, formGUID))
+
+	if f.Limit > 0 {
+		url = fmt.Sprintf("%s&limit=%d", url, f.Limit)
+	}
+
+	if after != "" {
+		url = fmt.Sprintf("%s&after=%s", url, neturl.QueryEscape(after))
+	}
+
+	return url
+}
+
+// Construct the proper URL to call
+func buildURL(f *Forms, u string) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, f.APIKey)
+
+	return url
+}
@@ -0,0 +1,16 @@
+// Package forms covers the Forms API, which is used to manage HubSpot forms, read the data submitted through them
+// and submit data to a form from your own backend.
+package forms
+
+import (
+	"testing"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmissionsURL(t *testing.T) {
+	f := New(client.NewClient().WithAPIKey("demo")).WithLimit(20)
+	assert.Equal(t, "form-integrations/v1/submissions/forms/abc?hapikey=demo&limit=20", submissionsURL(f, "abc", ""))
+	assert.Equal(t, "form-integrations/v1/submissions/forms/abc?hapikey=demo&limit=20&after=a%2Bb%3D%26c", submissionsURL(f, "abc", "a+b=&c"))
+}
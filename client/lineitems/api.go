@@ -0,0 +1,127 @@
+// Package lineitems covers the line items of deals and quotes. A line item is an instance of a product, with its
+// own quantity and price.
+package lineitems
+
+import (
+	"math/big"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+const (
+	// PropertyName is the name of the line item
+	PropertyName = "name"
+	// PropertyProductID is the ID of the product the line item was created from
+	PropertyProductID = "hs_product_id"
+	// PropertyQuantity is the number of units of the line item
+	PropertyQuantity = "quantity"
+	// PropertyPrice is the unit price of the line item
+	PropertyPrice = "price"
+	// PropertyAmount is the total amount of the line item, calculated by HubSpot
+	PropertyAmount = "amount"
+	// PropertyDiscount is the discount per unit as an amount
+	PropertyDiscount = "discount"
+	// PropertyDiscountPercentage is the discount per unit as a percentage
+	PropertyDiscountPercentage = "hs_discount_percentage"
+	// PropertySKU is the stock keeping unit of the line item
+	PropertySKU = "hs_sku"
+)
+
+// defaultProperties are the properties returned for each line item unless WithProperties is used
+var defaultProperties = []string{
+	PropertyName, PropertyProductID, PropertyQuantity, PropertyPrice, PropertyAmount, PropertyDiscount,
+	PropertyDiscountPercentage, PropertySKU,
+}
+
+// LineItem is a single line item of a deal or quote
+type LineItem struct {
+	crm.Object
+}
+
+// LineItemList is a single page of line items
+type LineItemList struct {
+	Results []LineItem
+	// After is the cursor of the next page, or an empty string when this is the last page
+	After string
+}
+
+// LineItemInput contains the property values to create or update a line item. When ProductID is set, HubSpot
+// copies the name and price of the product unless they are set as well. Empty fields are left out, and
+// Properties can hold any other property of the line item.
+type LineItemInput struct {
+	ProductID  string
+	Name       string
+	Quantity   *big.Rat
+	Price      *big.Rat
+	Properties map[string]string
+}
+
+// Name returns the name of the line item
+func (l *LineItem) Name() string {
+	return l.Property(PropertyName).String()
+}
+
+// ProductID returns the ID of the product the line item was created from
+func (l *LineItem) ProductID() string {
+	return l.Property(PropertyProductID).String()
+}
+
+// Quantity returns the number of units of the line item as an exact decimal
+func (l *LineItem) Quantity() (*big.Rat, error) {
+	return l.Property(PropertyQuantity).Decimal()
+}
+
+// Price returns the unit price of the line item as an exact decimal
+func (l *LineItem) Price() (*big.Rat, error) {
+	return l.Property(PropertyPrice).Decimal()
+}
+
+// Amount returns the total amount of the line item as an exact decimal
+func (l *LineItem) Amount() (*big.Rat, error) {
+	return l.Property(PropertyAmount).Decimal()
+}
+
+// properties returns the property values to send to HubSpot
+func (i *LineItemInput) properties() map[string]string {
+	props := make(map[string]string)
+
+	for key, val := range i.Properties {
+		props[key] = val
+	}
+
+	if i.ProductID != "" {
+		props[PropertyProductID] = i.ProductID
+	}
+
+	if i.Name != "" {
+		props[PropertyName] = i.Name
+	}
+
+	if i.Quantity != nil {
+		props[PropertyQuantity] = client.FormatDecimal(i.Quantity)
+	}
+
+	if i.Price != nil {
+		props[PropertyPrice] = client.FormatDecimal(i.Price)
+	}
+
+	return props
+}
+
+// createProperties returns the property values of each line item to create
+func createProperties(inputs []LineItemInput) []map[string]string {
+	props := make([]map[string]string, 0)
+	for idx := range inputs {
+		props = append(props, inputs[idx].properties())
+	}
+	return props
+}
+
+func newLineItems(objects []crm.Object) []LineItem {
+	lineItems := make([]LineItem, 0)
+	for idx := range objects {
+		lineItems = append(lineItems, LineItem{objects[idx]})
+	}
+	return lineItems
+}
@@ -0,0 +1,36 @@
+// Package lineitems covers the line items of deals and quotes. A line item is an instance of a product, with its
+// own quantity and price.
+package lineitems
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineItemInput(t *testing.T) {
+	price, _ := new(big.Rat).SetString("0.1")
+	input := LineItemInput{
+		ProductID:  "42",
+		Quantity:   big.NewRat(3, 2),
+		Price:      price.Mul(price, big.NewRat(3, 1)),
+		Properties: map[string]string{PropertyDiscount: "5"},
+	}
+
+	assert.Equal(t, map[string]string{
+		PropertyProductID: "42",
+		PropertyQuantity:  "1.5",
+		PropertyPrice:     "0.3",
+		PropertyDiscount:  "5",
+	}, input.properties())
+	assert.Equal(t, []map[string]string{{PropertyName: "Setup"}}, createProperties([]LineItemInput{{Name: "Setup"}}))
+
+	l := LineItem{crm.Object{Properties: map[string]string{PropertyQuantity: "1.5", PropertyPrice: "0.3"}}}
+	quantity, err := l.Quantity()
+	assert.NoError(t, err)
+	unitPrice, err := l.Price()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.45", new(big.Rat).Mul(quantity, unitPrice).FloatString(2))
+}
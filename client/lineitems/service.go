@@ -0,0 +1,188 @@
+// Package lineitems covers the line items of deals and quotes. A line item is an instance of a product, with its
+// own quantity and price.
+package lineitems
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/retgits/hubspot/client/crmassociations"
+)
+
+// LineItems contains the elements to communicate with the HubSpot line items endpoints.
+type LineItems struct {
+	*crm.Objects
+	Associations *crmassociations.CRMAssociations
+}
+
+// New creates a new instance of the LineItems service with default settings.
+func New(c *client.Client) *LineItems {
+	return &LineItems{
+		crm.New(c, crm.LineItems).WithProperties(defaultProperties), crmassociations.New(c),
+	}
+}
+
+// WithLimit sets the number of line items returned per page, returning a LineItems pointer for chaining.
+func (l *LineItems) WithLimit(limit int64) *LineItems {
+	l.Objects.WithLimit(limit)
+	return l
+}
+
+// WithProperties sets the properties returned for each line item, returning a LineItems pointer for chaining.
+func (l *LineItems) WithProperties(props []string) *LineItems {
+	l.Objects.WithProperties(props)
+	return l
+}
+
+// GetLineItem gets a single line item by its ID.
+func (l *LineItems) GetLineItem(lineItemID string) (LineItem, error) {
+	object, err := l.GetObject(lineItemID)
+	if err != nil {
+		return LineItem{}, err
+	}
+
+	return LineItem{object}, nil
+}
+
+// ListLineItems gets a single page of line items. Pass an empty after to get the first page, and the After of
+// the returned list to get the next one.
+func (l *LineItems) ListLineItems(after string) (LineItemList, error) {
+	temp, err := l.ListObjects(after)
+	if err != nil {
+		return LineItemList{}, err
+	}
+
+	return LineItemList{Results: newLineItems(temp.Results), After: temp.After()}, nil
+}
+
+// GetAllLineItems gets all line items of the portal.
+func (l *LineItems) GetAllLineItems() ([]LineItem, error) {
+	objects, err := l.GetAllObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	return newLineItems(objects), nil
+}
+
+// CreateLineItem creates a line item. A line item that isn't associated with a deal or quote doesn't show up
+// anywhere in HubSpot, so use CreateDealLineItem to create and associate it in one go.
+func (l *LineItems) CreateLineItem(input LineItemInput) (LineItem, error) {
+	object, err := l.CreateObject(input.properties())
+	if err != nil {
+		return LineItem{}, err
+	}
+
+	return LineItem{object}, nil
+}
+
+// UpdateLineItem updates a line item. Properties that aren't set in the input keep their value.
+func (l *LineItems) UpdateLineItem(lineItemID string, input LineItemInput) (LineItem, error) {
+	object, err := l.UpdateObject(lineItemID, input.properties())
+	if err != nil {
+		return LineItem{}, err
+	}
+
+	return LineItem{object}, nil
+}
+
+// DeleteLineItem moves a line item to the recycling bin.
+func (l *LineItems) DeleteLineItem(lineItemID string) error {
+	return l.ArchiveObject(lineItemID)
+}
+
+// BatchGetLineItems gets a group of line items by their IDs. Groups of more than 100 line items are read in
+// several calls.
+func (l *LineItems) BatchGetLineItems(lineItemIDs []string) ([]LineItem, error) {
+	res, err := l.BatchReadObjects(lineItemIDs)
+	if err != nil {
+		return newLineItems(res.Results), err
+	}
+
+	return newLineItems(res.Results), res.Err()
+}
+
+// BatchCreateLineItems creates a group of line items. When part of the group fails, the line items that were
+// created are returned together with the error.
+func (l *LineItems) BatchCreateLineItems(inputs []LineItemInput) ([]LineItem, error) {
+	res, err := l.BatchCreateObjects(createProperties(inputs))
+	if err != nil {
+		return newLineItems(res.Results), err
+	}
+
+	return newLineItems(res.Results), res.Err()
+}
+
+// CreateDealLineItem creates a line item and associates it with a deal. When the association fails, the line item
+// is archived again so it isn't left behind without a deal.
+func (l *LineItems) CreateDealLineItem(dealID string, input LineItemInput) (LineItem, error) {
+	lineItem, err := l.CreateLineItem(input)
+	if err != nil {
+		return LineItem{}, err
+	}
+
+	if err := l.AssociateWithDeal(lineItem.ID, dealID); err != nil {
+		if archiveErr := l.DeleteLineItem(lineItem.ID); archiveErr != nil {
+			return LineItem{}, fmt.Errorf("%s (line item %s was created but couldn't be archived: %s)", err.Error(), lineItem.ID, archiveErr.Error())
+		}
+		return LineItem{}, err
+	}
+
+	return lineItem, nil
+}
+
+// AssociateWithDeal associates a line item with a deal.
+func (l *LineItems) AssociateWithDeal(lineItemID string, dealID string) error {
+	association, err := newDealAssociation(lineItemID, dealID)
+	if err != nil {
+		return err
+	}
+
+	return l.Associations.CreateAssociation(association)
+}
+
+// RemoveFromDeal removes the association between a line item and a deal.
+func (l *LineItems) RemoveFromDeal(lineItemID string, dealID string) error {
+	association, err := newDealAssociation(lineItemID, dealID)
+	if err != nil {
+		return err
+	}
+
+	return l.Associations.DeleteAssociation(association)
+}
+
+// GetDealLineItems gets the line items associated with a deal.
+func (l *LineItems) GetDealLineItems(dealID string) ([]LineItem, error) {
+	id, err := strconv.ParseInt(dealID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	lineItemIDs, err := l.Associations.DealLineItems(id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for idx := range lineItemIDs {
+		ids = append(ids, strconv.FormatInt(lineItemIDs[idx], 10))
+	}
+
+	return l.BatchGetLineItems(ids)
+}
+
+func newDealAssociation(lineItemID string, dealID string) (crmassociations.Association, error) {
+	from, err := strconv.ParseInt(lineItemID, 10, 64)
+	if err != nil {
+		return crmassociations.Association{}, err
+	}
+
+	to, err := strconv.ParseInt(dealID, 10, 64)
+	if err != nil {
+		return crmassociations.Association{}, err
+	}
+
+	return crmassociations.NewAssociation(from, to, crmassociations.LineItemToDeal.Definition()), nil
+}
@@ -0,0 +1,316 @@
+// Package mapping converts between your own Go structs and the properties of HubSpot records, using struct tags
+// with the name of the HubSpot property:
+//
+//	type Lead struct {
+//		Email     string    `hubspot:"email"`
+//		Employees int64     `hubspot:"num_employees,omitempty"`
+//		Customer  bool      `hubspot:"is_customer"`
+//		CloseDate time.Time `hubspot:"closedate,date"`
+//		Channels  []string  `hubspot:"channels"`
+//		Internal  string    `hubspot:"-"`
+//	}
+//
+// Marshal turns a struct into the map[string]string accepted by UpdateContact, UpdateDeal and the other write
+// calls, and the Unmarshal functions fill a struct from a contact, deal, ticket or any CRM object. The omitempty
+// option leaves zero values out of the map, and the date option writes time.Time fields as dates at midnight UTC.
+// A nil pointer or a zero time.Time has no value to write and is left out of the map as well, unless the field has
+// the clear option, which sends an empty value to clear the property in HubSpot.
+// Fields can be strings, bools, integers, floats, time.Time, []string (multi-select properties), client.PropertyValue
+// or pointers to these.
+package mapping
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/contacts"
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/retgits/hubspot/client/deals"
+	"github.com/retgits/hubspot/client/tickets"
+)
+
+const (
+	// tagName is the name of the struct tag
+	tagName = "hubspot"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	propertyValueType = reflect.TypeOf(client.PropertyValue{})
+)
+
+// field is a struct field with a hubspot tag
+type field struct {
+	index     int
+	name      string
+	omitEmpty bool
+	date      bool
+	clear     bool
+}
+
+// fields returns the tagged fields of a struct type
+func fields(t reflect.Type) []field {
+	result := make([]field, 0)
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup(tagName)
+		if !ok || tag == "-" || sf.PkgPath != "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		f := field{index: i, name: parts[0]}
+		for _, opt := range parts[1:] {
+			switch opt {
+			case "omitempty":
+				f.omitEmpty = true
+			case "date":
+				f.date = true
+			case "clear":
+				f.clear = true
+			}
+		}
+		if f.name == "" {
+			f.name = strings.ToLower(sf.Name)
+		}
+
+		result = append(result, f)
+	}
+
+	return result
+}
+
+// structValue returns the struct v points to, or an error when v isn't a (pointer to a) struct
+func structValue(v interface{}, needPointer bool) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}, fmt.Errorf("mapping: nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	} else if needPointer {
+		return reflect.Value{}, fmt.Errorf("mapping: expected a pointer to a struct, got %s", rv.Type())
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("mapping: expected a struct, got %s", rv.Type())
+	}
+
+	return rv, nil
+}
+
+// Marshal returns the property values of the tagged fields of a struct, formatted the way HubSpot expects
+// them on writes.
+func Marshal(v interface{}) (map[string]string, error) {
+	rv, err := structValue(v, false)
+	if err != nil {
+		return nil, err
+	}
+
+	props := make(map[string]string)
+
+	for _, f := range fields(rv.Type()) {
+		fv := rv.Field(f.index)
+
+		if f.omitEmpty && isEmpty(fv) {
+			continue
+		}
+
+		value, ok, err := format(fv, f)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok || f.clear {
+			props[f.name] = value
+		}
+	}
+
+	return props, nil
+}
+
+// isEmpty returns true for the zero value of a field, an empty slice or an unset PropertyValue
+func isEmpty(fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return fv.Len() == 0
+	case reflect.Ptr:
+		return fv.IsNil()
+	}
+
+	return reflect.DeepEqual(fv.Interface(), reflect.Zero(fv.Type()).Interface())
+}
+
+// format turns a field value into the string sent to HubSpot. It returns false when the field has no value to
+// write, which is the case for a nil pointer or a zero time.Time.
+func format(fv reflect.Value, f field) (string, bool, error) {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return "", false, nil
+		}
+		fv = fv.Elem()
+	}
+
+	var p client.PropertyValue
+
+	switch {
+	case fv.Type() == propertyValueType:
+		p = fv.Interface().(client.PropertyValue)
+	case fv.Type() == timeType:
+		t := fv.Interface().(time.Time)
+		if t.IsZero() {
+			return "", false, nil
+		}
+		if f.date {
+			p.SetDate(t)
+		} else {
+			p.SetTime(t)
+		}
+	case fv.Kind() == reflect.String:
+		p.SetString(fv.String())
+	case fv.Kind() == reflect.Bool:
+		p.SetBool(fv.Bool())
+	case fv.Kind() >= reflect.Int && fv.Kind() <= reflect.Int64:
+		p.SetInt64(fv.Int())
+	case fv.Kind() >= reflect.Uint && fv.Kind() <= reflect.Uint64:
+		if fv.Uint() > math.MaxInt64 {
+			return "", false, fmt.Errorf("mapping: value %d of property %s is too large", fv.Uint(), f.name)
+		}
+		p.SetInt64(int64(fv.Uint()))
+	case fv.Kind() == reflect.Float32:
+		p.SetString(strconv.FormatFloat(fv.Float(), 'f', -1, 32))
+	case fv.Kind() == reflect.Float64:
+		p.SetFloat(fv.Float())
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+		values := make([]string, 0)
+		for i := 0; i < fv.Len(); i++ {
+			values = append(values, fv.Index(i).String())
+		}
+		p.SetMulti(values)
+	default:
+		return "", false, fmt.Errorf("mapping: unsupported type %s for property %s", fv.Type(), f.name)
+	}
+
+	return p.Value, true, nil
+}
+
+// Unmarshal fills the tagged fields of the struct v points to with the property values. Properties that are
+// missing or empty leave the field unchanged.
+func Unmarshal(props map[string]client.PropertyValue, v interface{}) error {
+	rv, err := structValue(v, true)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields(rv.Type()) {
+		p, ok := props[f.name]
+		if !ok || (!p.IsSet() && rv.Field(f.index).Type() != propertyValueType) {
+			continue
+		}
+
+		if err := parse(rv.Field(f.index), p); err != nil {
+			return fmt.Errorf("mapping: property %s: %s", f.name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// parse sets a field from a property value
+func parse(fv reflect.Value, p client.PropertyValue) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+
+	switch {
+	case fv.Type() == propertyValueType:
+		fv.Set(reflect.ValueOf(p))
+	case fv.Type() == timeType:
+		t, err := p.Time()
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(t))
+	case fv.Kind() == reflect.String:
+		fv.SetString(p.String())
+	case fv.Kind() == reflect.Bool:
+		b, err := p.Bool()
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case fv.Kind() >= reflect.Int && fv.Kind() <= reflect.Int64:
+		i, err := p.Int64()
+		if err != nil {
+			return err
+		}
+		if fv.OverflowInt(i) {
+			return fmt.Errorf("value %d overflows %s", i, fv.Type())
+		}
+		fv.SetInt(i)
+	case fv.Kind() >= reflect.Uint && fv.Kind() <= reflect.Uint64:
+		u, err := strconv.ParseUint(p.Value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if fv.OverflowUint(u) {
+			return fmt.Errorf("value %d overflows %s", u, fv.Type())
+		}
+		fv.SetUint(u)
+	case fv.Kind() == reflect.Float32 || fv.Kind() == reflect.Float64:
+		f, err := p.Float()
+		if err != nil {
+			return err
+		}
+		if fv.OverflowFloat(f) {
+			return fmt.Errorf("value %s overflows %s", p.Value, fv.Type())
+		}
+		fv.SetFloat(f)
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+		values := p.Multi()
+		slice := reflect.MakeSlice(fv.Type(), len(values), len(values))
+		for i := range values {
+			slice.Index(i).SetString(values[i])
+		}
+		fv.Set(slice)
+	default:
+		return fmt.Errorf("unsupported type %s", fv.Type())
+	}
+
+	return nil
+}
+
+// UnmarshalContact fills the struct v points to with the properties of a contact.
+func UnmarshalContact(contact contacts.Contact, v interface{}) error {
+	return Unmarshal(contact.Properties, v)
+}
+
+// UnmarshalDeal fills the struct v points to with the properties of a deal.
+func UnmarshalDeal(deal deals.Deal, v interface{}) error {
+	return Unmarshal(deal.Properties, v)
+}
+
+// UnmarshalTicket fills the struct v points to with the properties of a ticket.
+func UnmarshalTicket(ticket tickets.Object, v interface{}) error {
+	return Unmarshal(ticket.Properties, v)
+}
+
+// UnmarshalObject fills the struct v points to with the properties of a CRM object, like a company, product or
+// custom object.
+func UnmarshalObject(object crm.Object, v interface{}) error {
+	props := make(map[string]client.PropertyValue)
+	for name := range object.Properties {
+		props[name] = object.Property(name)
+	}
+
+	return Unmarshal(props, v)
+}
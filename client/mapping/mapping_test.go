@@ -0,0 +1,115 @@
+package mapping
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/stretchr/testify/assert"
+)
+
+type lead struct {
+	Email     string    `hubspot:"email"`
+	Employees int64     `hubspot:"num_employees,omitempty"`
+	Revenue   *float64  `hubspot:"annualrevenue,omitempty"`
+	Customer  bool      `hubspot:"is_customer"`
+	CloseDate time.Time `hubspot:"closedate,date"`
+	Channels  []string  `hubspot:"channels,omitempty"`
+	Internal  string    `hubspot:"-"`
+}
+
+func TestMarshal(t *testing.T) {
+	props, err := Marshal(lead{
+		Email:     "codey@example.com",
+		Customer:  true,
+		CloseDate: time.Date(2019, 5, 16, 12, 0, 0, 0, time.UTC),
+		Internal:  "secret",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"email":       "codey@example.com",
+		"is_customer": "true",
+		"closedate":   "1557964800000",
+	}, props)
+}
+
+func TestUnmarshal(t *testing.T) {
+	var l lead
+	err := UnmarshalObject(crm.Object{Properties: map[string]string{
+		"email":         "codey@example.com",
+		"num_employees": "42",
+		"annualrevenue": "1000000.5",
+		"is_customer":   "false",
+		"closedate":     "2019-05-16",
+		"channels":      "web;phone",
+	}}, &l)
+	assert.NoError(t, err)
+	assert.Equal(t, "codey@example.com", l.Email)
+	assert.Equal(t, int64(42), l.Employees)
+	assert.Equal(t, 1000000.5, *l.Revenue)
+	assert.Equal(t, time.Date(2019, 5, 16, 0, 0, 0, 0, time.UTC), l.CloseDate)
+	assert.Equal(t, []string{"web", "phone"}, l.Channels)
+
+	err = Unmarshal(map[string]client.PropertyValue{"num_employees": {Value: "many"}}, &l)
+	assert.Error(t, err)
+
+	err = Unmarshal(nil, l)
+	assert.Error(t, err)
+}
+
+func TestMarshalUnset(t *testing.T) {
+	type update struct {
+		Revenue   *float64  `hubspot:"annualrevenue"`
+		CloseDate time.Time `hubspot:"closedate,date"`
+		Owner     *string   `hubspot:"hubspot_owner_id,clear"`
+		Renewal   time.Time `hubspot:"renewal_date,date,clear"`
+	}
+
+	props, err := Marshal(update{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"hubspot_owner_id": "",
+		"renewal_date":     "",
+	}, props)
+
+	_, err = Marshal(struct {
+		Count uint64 `hubspot:"count"`
+	}{Count: math.MaxUint64})
+	assert.Error(t, err)
+}
+
+func TestUnmarshalOverflow(t *testing.T) {
+	type counts struct {
+		Small    int8    `hubspot:"small"`
+		Unsigned uint16  `hubspot:"unsigned"`
+		Ratio    float32 `hubspot:"ratio"`
+	}
+
+	var c counts
+	err := Unmarshal(map[string]client.PropertyValue{
+		"small":    {Value: "100"},
+		"unsigned": {Value: "65535"},
+		"ratio":    {Value: "0.1"},
+	}, &c)
+	assert.NoError(t, err)
+	assert.Equal(t, counts{Small: 100, Unsigned: 65535, Ratio: 0.1}, c)
+
+	props, err := Marshal(c)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", props["ratio"])
+
+	tests := map[string]string{
+		"small":    "128",
+		"unsigned": "-1",
+		"ratio":    "1e39",
+	}
+	for name, value := range tests {
+		err := Unmarshal(map[string]client.PropertyValue{name: {Value: value}}, &counts{})
+		assert.Error(t, err, name)
+	}
+
+	err = Unmarshal(map[string]client.PropertyValue{"unsigned": {Value: "65536"}}, &counts{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,69 @@
+// Package owners covers the CRM Owners API. Owners are the users of a portal that contacts, companies, deals,
+// tickets and engagements can be assigned to.
+package owners
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/retgits/hubspot/client/crm"
+)
+
+const (
+	// OwnerProperty is the name of the property containing the owner ID of contacts, companies, deals and tickets
+	OwnerProperty = "hubspot_owner_id"
+)
+
+// HubSpotOwners is the payload returned after listing owners
+type HubSpotOwners struct {
+	Results []Owner     `json:"results"`
+	Paging  *crm.Paging `json:"paging,omitempty"`
+}
+
+// Owner is a struct generated from the HubSpot API
+type Owner struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	UserID    int64     `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Archived  bool      `json:"archived"`
+	Teams     []Team    `json:"teams,omitempty"`
+}
+
+// Team is a team an owner is part of
+type Team struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Primary bool   `json:"primary"`
+}
+
+// Name returns the full name of the owner
+func (o *Owner) Name() string {
+	return strings.TrimSpace(o.FirstName + " " + o.LastName)
+}
+
+// PrimaryTeam returns the primary team of the owner, or nil when the owner isn't part of a team
+func (o *Owner) PrimaryTeam() *Team {
+	for idx := range o.Teams {
+		if o.Teams[idx].Primary {
+			return &o.Teams[idx]
+		}
+	}
+	return nil
+}
+
+func unmarshalHubSpotOwners(data []byte) (HubSpotOwners, error) {
+	var r HubSpotOwners
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalOwner(data []byte) (Owner, error) {
+	var r Owner
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
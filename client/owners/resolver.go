@@ -0,0 +1,114 @@
+// Package owners covers the CRM Owners API. Owners are the users of a portal that contacts, companies, deals,
+// tickets and engagements can be assigned to.
+package owners
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/contacts"
+	"github.com/retgits/hubspot/client/deals"
+	"github.com/retgits/hubspot/client/engagement"
+	"github.com/retgits/hubspot/client/tickets"
+)
+
+// ErrNoOwner is returned by the Resolver when a record isn't assigned to an owner
+var ErrNoOwner = errors.New("record has no owner")
+
+// Resolver looks up the owners that records are assigned to. Owners are cached after the first lookup, so a
+// Resolver can be shared between goroutines to resolve the owners of many records with few calls.
+type Resolver struct {
+	mu     sync.Mutex
+	owners map[string]Owner
+	fetch  func(ownerID string) (Owner, error)
+	load   func() ([]Owner, error)
+	// fetchArchived looks up deactivated owners, which records can still be assigned to
+	fetchArchived func(ownerID string) (Owner, error)
+}
+
+// NewResolver creates a new Resolver that uses the Owners service to look up owners.
+func NewResolver(c *client.Client) *Resolver {
+	svc := New(c)
+	archived := New(c).WithArchived(true)
+
+	return &Resolver{
+		owners:        make(map[string]Owner),
+		fetch:         svc.GetOwner,
+		load:          svc.GetAllOwners,
+		fetchArchived: archived.GetOwner,
+	}
+}
+
+// Preload fills the cache with all active owners of the portal in as few calls as possible.
+func (r *Resolver) Preload() error {
+	owners, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, o := range owners {
+		r.owners[o.ID] = o
+	}
+
+	return nil
+}
+
+// Resolve returns the owner with the given owner ID. Owners that have been deactivated are looked up among the
+// archived owners when they can't be found among the active ones.
+func (r *Resolver) Resolve(ownerID string) (Owner, error) {
+	if ownerID == "" || ownerID == "0" {
+		return Owner{}, ErrNoOwner
+	}
+
+	r.mu.Lock()
+	owner, ok := r.owners[ownerID]
+	r.mu.Unlock()
+
+	if ok {
+		return owner, nil
+	}
+
+	owner, err := r.fetch(ownerID)
+	if err != nil {
+		if r.fetchArchived == nil {
+			return Owner{}, err
+		}
+
+		archived, archivedErr := r.fetchArchived(ownerID)
+		if archivedErr != nil {
+			return Owner{}, err
+		}
+		owner = archived
+	}
+
+	r.mu.Lock()
+	r.owners[ownerID] = owner
+	r.mu.Unlock()
+
+	return owner, nil
+}
+
+// ForContact returns the owner of a contact. The hubspot_owner_id property must have been requested.
+func (r *Resolver) ForContact(contact contacts.Contact) (Owner, error) {
+	return r.Resolve(contact.Properties[OwnerProperty].String())
+}
+
+// ForDeal returns the owner of a deal.
+func (r *Resolver) ForDeal(deal deals.Deal) (Owner, error) {
+	return r.Resolve(deal.Properties[OwnerProperty].String())
+}
+
+// ForTicket returns the owner of a ticket. The hubspot_owner_id property must have been requested.
+func (r *Resolver) ForTicket(ticket tickets.Object) (Owner, error) {
+	return r.Resolve(ticket.Property(OwnerProperty))
+}
+
+// ForEngagement returns the owner of an engagement.
+func (r *Resolver) ForEngagement(e engagement.HubspotEngagement) (Owner, error) {
+	return r.Resolve(strconv.FormatInt(e.Engagement.OwnerID, 10))
+}
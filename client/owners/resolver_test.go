@@ -0,0 +1,59 @@
+// Package owners covers the CRM Owners API. Owners are the users of a portal that contacts, companies, deals,
+// tickets and engagements can be assigned to.
+package owners
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/deals"
+	"github.com/retgits/hubspot/client/engagement"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolver(t *testing.T) {
+	calls := 0
+	r := &Resolver{
+		owners: make(map[string]Owner),
+		fetch: func(ownerID string) (Owner, error) {
+			calls++
+			return Owner{ID: ownerID, FirstName: "Codey", LastName: "Robot"}, nil
+		},
+	}
+
+	deal := deals.Deal{Properties: map[string]client.PropertyValue{OwnerProperty: {Value: "42"}}}
+	owner, err := r.ForDeal(deal)
+	assert.NoError(t, err)
+	assert.Equal(t, "Codey Robot", owner.Name())
+
+	owner, err = r.ForEngagement(engagement.HubspotEngagement{Engagement: engagement.Engagement{OwnerID: 42}})
+	assert.NoError(t, err)
+	assert.Equal(t, "42", owner.ID)
+	assert.Equal(t, 1, calls)
+
+	_, err = r.ForDeal(deals.Deal{})
+	assert.Equal(t, ErrNoOwner, err)
+}
+
+func TestResolverArchived(t *testing.T) {
+	r := &Resolver{
+		owners: make(map[string]Owner),
+		fetch: func(ownerID string) (Owner, error) {
+			return Owner{}, errors.New("not found")
+		},
+		fetchArchived: func(ownerID string) (Owner, error) {
+			if ownerID == "7" {
+				return Owner{ID: ownerID, Archived: true}, nil
+			}
+			return Owner{}, errors.New("archived not found")
+		},
+	}
+
+	owner, err := r.Resolve("7")
+	assert.NoError(t, err)
+	assert.True(t, owner.Archived)
+
+	_, err = r.Resolve("8")
+	assert.EqualError(t, err, "not found")
+}
@@ -0,0 +1,119 @@
+// Package owners covers the CRM Owners API. Owners are the users of a portal that contacts, companies, deals,
+// tickets and engagements can be assigned to.
+package owners
+
+import (
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	defaultLimit int64 = 100
+	// ownersEndpoint is the endpoint to list owners
+	ownersEndpoint = "crm/v3/owners"
+	// ownerEndpoint is the endpoint to get a single owner
+	ownerEndpoint = "crm/v3/owners/%s"
+)
+
+// Owners contains the elements to communicate with the HubSpot Owners endpoints.
+type Owners struct {
+	*client.Client
+	Limit    int64
+	Email    string
+	Archived bool
+}
+
+// New creates a new instance of the Owners service with default settings.
+func New(c *client.Client) *Owners {
+	return &Owners{
+		c, defaultLimit, "", false,
+	}
+}
+
+// WithLimit sets the number of owners returned per page, returning an Owners pointer for chaining.
+func (o *Owners) WithLimit(limit int64) *Owners {
+	o.Limit = limit
+	return o
+}
+
+// WithEmail only returns the owner with the given email address, returning an Owners pointer for chaining.
+func (o *Owners) WithEmail(email string) *Owners {
+	o.Email = email
+	return o
+}
+
+// WithArchived sets whether archived (deactivated) owners are returned instead of active ones, returning an
+// Owners pointer for chaining.
+func (o *Owners) WithArchived(archived bool) *Owners {
+	o.Archived = archived
+	return o
+}
+
+// GetAllOwners gets all owners of the portal, following the paging cursor until the last page.
+func (o *Owners) GetAllOwners() ([]Owner, error) {
+	after := ""
+	owners := make([]Owner, 0)
+
+	for {
+		url := buildURL(o, ownersEndpoint)
+
+		if o.Email != "" {
+			url = fmt.Sprintf("%s&email=%s", url, neturl.QueryEscape(o.Email))
+		}
+
+		if o.Limit > 0 {
+			url = fmt.Sprintf("%s&limit=%d", url, o.Limit)
+		}
+
+		if after != "" {
+			url = fmt.Sprintf("%s&after=%s", url, neturl.QueryEscape(after))
+		}
+
+		res, err := o.Call(url, http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		temp, err := unmarshalHubSpotOwners(res)
+		if err != nil {
+			return nil, err
+		}
+
+		owners = append(owners, temp.Results...)
+
+		if temp.Paging == nil || temp.Paging.Next == nil || temp.Paging.Next.After == "" {
+			break
+		}
+		after = temp.Paging.Next.After
+	}
+
+	return owners, nil
+}
+
+// GetOwner gets a single owner by its owner ID.
+func (o *Owners) GetOwner(ownerID string) (Owner, error) {
+	url := buildURL(o, fmt.Sprintf(ownerEndpoint, ownerID))
+	url = fmt.Sprintf("%s&idProperty=id", url)
+
+	res, err := o.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Owner{}, err
+	}
+
+	return unmarshalOwner(res)
+}
+
+// Construct the proper URL to call
+func buildURL(o *Owners, u string) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, o.APIKey)
+
+	if o.Archived {
+		url = fmt.Sprintf("%s&archived=true", url)
+	}
+
+	return url
+}
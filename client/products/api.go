@@ -0,0 +1,125 @@
+// Package products covers the products in the product library, which are the templates for the line items
+// added to deals and quotes.
+package products
+
+import (
+	"math/big"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+const (
+	// PropertyName is the name of the product
+	PropertyName = "name"
+	// PropertyDescription is the description of the product
+	PropertyDescription = "description"
+	// PropertyPrice is the unit price of the product
+	PropertyPrice = "price"
+	// PropertySKU is the stock keeping unit of the product
+	PropertySKU = "hs_sku"
+	// PropertyCostOfGoodsSold is the unit cost of the product
+	PropertyCostOfGoodsSold = "hs_cost_of_goods_sold"
+	// PropertyRecurringBillingPeriod is the term of a recurring product, as an ISO 8601 period like P12M
+	PropertyRecurringBillingPeriod = "hs_recurring_billing_period"
+)
+
+// defaultProperties are the properties returned for each product unless WithProperties is used
+var defaultProperties = []string{
+	PropertyName, PropertyDescription, PropertyPrice, PropertySKU, PropertyCostOfGoodsSold, PropertyRecurringBillingPeriod,
+}
+
+// Product is a single product in the product library
+type Product struct {
+	crm.Object
+}
+
+// ProductList is a single page of products
+type ProductList struct {
+	Results []Product
+	// After is the cursor of the next page, or an empty string when this is the last page
+	After string
+}
+
+// ProductInput contains the property values to create or update a product. Empty fields are left out, and
+// Properties can hold any other property of the product.
+type ProductInput struct {
+	Name        string
+	Description string
+	SKU         string
+	Price       *big.Rat
+	Properties  map[string]string
+}
+
+// Name returns the name of the product
+func (p *Product) Name() string {
+	return p.Property(PropertyName).String()
+}
+
+// Description returns the description of the product
+func (p *Product) Description() string {
+	return p.Property(PropertyDescription).String()
+}
+
+// SKU returns the stock keeping unit of the product
+func (p *Product) SKU() string {
+	return p.Property(PropertySKU).String()
+}
+
+// Price returns the unit price of the product as an exact decimal
+func (p *Product) Price() (*big.Rat, error) {
+	return p.Property(PropertyPrice).Decimal()
+}
+
+// properties returns the property values to send to HubSpot
+func (i *ProductInput) properties() map[string]string {
+	props := make(map[string]string)
+
+	for key, val := range i.Properties {
+		props[key] = val
+	}
+
+	if i.Name != "" {
+		props[PropertyName] = i.Name
+	}
+
+	if i.Description != "" {
+		props[PropertyDescription] = i.Description
+	}
+
+	if i.SKU != "" {
+		props[PropertySKU] = i.SKU
+	}
+
+	if i.Price != nil {
+		props[PropertyPrice] = client.FormatDecimal(i.Price)
+	}
+
+	return props
+}
+
+// createProperties returns the property values of each product to create
+func createProperties(inputs []ProductInput) []map[string]string {
+	props := make([]map[string]string, 0)
+	for idx := range inputs {
+		props = append(props, inputs[idx].properties())
+	}
+	return props
+}
+
+// updateProperties returns the property values to update, keyed by product ID
+func updateProperties(inputs map[string]ProductInput) map[string]map[string]string {
+	props := make(map[string]map[string]string)
+	for productID, input := range inputs {
+		props[productID] = input.properties()
+	}
+	return props
+}
+
+func newProducts(objects []crm.Object) []Product {
+	products := make([]Product, 0)
+	for idx := range objects {
+		products = append(products, Product{objects[idx]})
+	}
+	return products
+}
@@ -0,0 +1,43 @@
+// Package products covers the products in the product library, which are the templates for the line items
+// added to deals and quotes.
+package products
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductInput(t *testing.T) {
+	price, _ := new(big.Rat).SetString("19.99")
+	input := ProductInput{
+		Name:       "Widget",
+		SKU:        "W-1",
+		Price:      price,
+		Properties: map[string]string{PropertyName: "ignored", PropertyRecurringBillingPeriod: "P12M"},
+	}
+
+	assert.Equal(t, map[string]string{
+		PropertyName:                   "Widget",
+		PropertySKU:                    "W-1",
+		PropertyPrice:                  "19.99",
+		PropertyRecurringBillingPeriod: "P12M",
+	}, input.properties())
+
+	assert.Equal(t, []map[string]string{{PropertyName: "Gadget"}}, createProperties([]ProductInput{{Name: "Gadget"}}))
+
+	assert.Equal(t, map[string]map[string]string{
+		"1": {PropertyPrice: "5"},
+		"2": {PropertyDescription: "Blue"},
+	}, updateProperties(map[string]ProductInput{
+		"1": {Price: big.NewRat(5, 1)},
+		"2": {Description: "Blue"},
+	}))
+
+	p := Product{crm.Object{Properties: map[string]string{PropertyPrice: "0.10"}}}
+	unitPrice, err := p.Price()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.3", new(big.Rat).Mul(unitPrice, big.NewRat(3, 1)).FloatString(1))
+}
@@ -0,0 +1,126 @@
+// Package products covers the products in the product library, which are the templates for the line items
+// added to deals and quotes.
+package products
+
+import (
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+// Products contains the elements to communicate with the HubSpot products endpoints.
+type Products struct {
+	*crm.Objects
+}
+
+// New creates a new instance of the Products service with default settings.
+func New(c *client.Client) *Products {
+	return &Products{
+		crm.New(c, crm.Products).WithProperties(defaultProperties),
+	}
+}
+
+// WithLimit sets the number of products returned per page, returning a Products pointer for chaining.
+func (p *Products) WithLimit(limit int64) *Products {
+	p.Objects.WithLimit(limit)
+	return p
+}
+
+// WithProperties sets the properties returned for each product, returning a Products pointer for chaining.
+func (p *Products) WithProperties(props []string) *Products {
+	p.Objects.WithProperties(props)
+	return p
+}
+
+// GetProduct gets a single product by its ID.
+func (p *Products) GetProduct(productID string) (Product, error) {
+	object, err := p.GetObject(productID)
+	if err != nil {
+		return Product{}, err
+	}
+
+	return Product{object}, nil
+}
+
+// ListProducts gets a single page of products. Pass an empty after to get the first page, and the After of the
+// returned list to get the next one.
+func (p *Products) ListProducts(after string) (ProductList, error) {
+	temp, err := p.ListObjects(after)
+	if err != nil {
+		return ProductList{}, err
+	}
+
+	return ProductList{Results: newProducts(temp.Results), After: temp.After()}, nil
+}
+
+// GetAllProducts gets all products in the product library.
+func (p *Products) GetAllProducts() ([]Product, error) {
+	objects, err := p.GetAllObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	return newProducts(objects), nil
+}
+
+// CreateProduct creates a product.
+func (p *Products) CreateProduct(input ProductInput) (Product, error) {
+	object, err := p.CreateObject(input.properties())
+	if err != nil {
+		return Product{}, err
+	}
+
+	return Product{object}, nil
+}
+
+// UpdateProduct updates a product. Properties that aren't set in the input keep their value.
+func (p *Products) UpdateProduct(productID string, input ProductInput) (Product, error) {
+	object, err := p.UpdateObject(productID, input.properties())
+	if err != nil {
+		return Product{}, err
+	}
+
+	return Product{object}, nil
+}
+
+// DeleteProduct moves a product to the recycling bin. Line items created from the product are kept.
+func (p *Products) DeleteProduct(productID string) error {
+	return p.ArchiveObject(productID)
+}
+
+// BatchGetProducts gets a group of products by their IDs. Groups of more than 100 products are read in several
+// calls.
+func (p *Products) BatchGetProducts(productIDs []string) ([]Product, error) {
+	res, err := p.BatchReadObjects(productIDs)
+	if err != nil {
+		return newProducts(res.Results), err
+	}
+
+	return newProducts(res.Results), res.Err()
+}
+
+// BatchCreateProducts creates a group of products. When part of the group fails, the products that were created
+// are returned together with the error.
+func (p *Products) BatchCreateProducts(inputs []ProductInput) ([]Product, error) {
+	res, err := p.BatchCreateObjects(createProperties(inputs))
+	if err != nil {
+		return newProducts(res.Results), err
+	}
+
+	return newProducts(res.Results), res.Err()
+}
+
+// BatchUpdateProducts updates a group of products. The map is keyed by product ID. When part of the group fails,
+// the products that were updated are returned together with the error.
+func (p *Products) BatchUpdateProducts(inputs map[string]ProductInput) ([]Product, error) {
+	res, err := p.BatchUpdateObjects(updateProperties(inputs))
+	if err != nil {
+		return newProducts(res.Results), err
+	}
+
+	return newProducts(res.Results), res.Err()
+}
+
+// BatchDeleteProducts moves a group of products to the recycling bin.
+func (p *Products) BatchDeleteProducts(productIDs []string) error {
+	return p.BatchArchiveObjects(productIDs)
+}
@@ -0,0 +1,140 @@
+// Package properties covers the CRM Properties API, which is used to manage the properties that store data on
+// contacts, companies, deals, tickets, products, line items, quotes and custom objects, and the groups the
+// properties are organized in.
+package properties
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// The data types a property can have
+const (
+	TypeString      = "string"
+	TypeNumber      = "number"
+	TypeDate        = "date"
+	TypeDateTime    = "datetime"
+	TypeEnumeration = "enumeration"
+	TypeBool        = "bool"
+)
+
+// The ways a property can be shown in HubSpot and on forms
+const (
+	FieldTypeText            = "text"
+	FieldTypeTextArea        = "textarea"
+	FieldTypeNumber          = "number"
+	FieldTypeDate            = "date"
+	FieldTypeFile            = "file"
+	FieldTypePhoneNumber     = "phonenumber"
+	FieldTypeSelect          = "select"
+	FieldTypeRadio           = "radio"
+	FieldTypeCheckbox        = "checkbox"
+	FieldTypeBooleanCheckbox = "booleancheckbox"
+	FieldTypeCalculation     = "calculation_equation"
+	FieldTypeHTML            = "html"
+)
+
+// HubSpotProperties is the payload returned after listing properties
+type HubSpotProperties struct {
+	Results []Property `json:"results"`
+}
+
+// HubSpotGroups is the payload returned after listing property groups
+type HubSpotGroups struct {
+	Results []Group `json:"results"`
+}
+
+// Property is the definition of a property
+type Property struct {
+	Name                 string                `json:"name"`
+	Label                string                `json:"label"`
+	Type                 string                `json:"type"`
+	FieldType            string                `json:"fieldType"`
+	Description          string                `json:"description,omitempty"`
+	GroupName            string                `json:"groupName,omitempty"`
+	Options              []Option              `json:"options,omitempty"`
+	DisplayOrder         int64                 `json:"displayOrder,omitempty"`
+	HasUniqueValue       bool                  `json:"hasUniqueValue,omitempty"`
+	Hidden               bool                  `json:"hidden,omitempty"`
+	FormField            bool                  `json:"formField,omitempty"`
+	Calculated           bool                  `json:"calculated,omitempty"`
+	CalculationFormula   string                `json:"calculationFormula,omitempty"`
+	ExternalOptions      bool                  `json:"externalOptions,omitempty"`
+	ReferencedObjectType string                `json:"referencedObjectType,omitempty"`
+	ShowCurrencySymbol   bool                  `json:"showCurrencySymbol,omitempty"`
+	ModificationMetadata *ModificationMetadata `json:"modificationMetadata,omitempty"`
+	CreatedAt            *time.Time            `json:"createdAt,omitempty"`
+	UpdatedAt            *time.Time            `json:"updatedAt,omitempty"`
+	Archived             bool                  `json:"archived,omitempty"`
+}
+
+// PropertyUpdate holds the changes to the definition of a property. Only the fields that are set are sent, so
+// the rest of the definition stays as it is. Use the pointer fields to set a flag or the display order to its
+// zero value.
+type PropertyUpdate struct {
+	Label              string   `json:"label,omitempty"`
+	Type               string   `json:"type,omitempty"`
+	FieldType          string   `json:"fieldType,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	GroupName          string   `json:"groupName,omitempty"`
+	Options            []Option `json:"options,omitempty"`
+	DisplayOrder       *int64   `json:"displayOrder,omitempty"`
+	Hidden             *bool    `json:"hidden,omitempty"`
+	FormField          *bool    `json:"formField,omitempty"`
+	CalculationFormula string   `json:"calculationFormula,omitempty"`
+}
+
+// Option is a possible value of an enumeration property
+type Option struct {
+	Label        string `json:"label"`
+	Value        string `json:"value"`
+	Description  string `json:"description,omitempty"`
+	DisplayOrder int64  `json:"displayOrder,omitempty"`
+	Hidden       bool   `json:"hidden"`
+}
+
+// ModificationMetadata describes which parts of a property can be changed
+type ModificationMetadata struct {
+	Archivable         bool `json:"archivable"`
+	ReadOnlyDefinition bool `json:"readOnlyDefinition"`
+	ReadOnlyValue      bool `json:"readOnlyValue"`
+	ReadOnlyOptions    bool `json:"readOnlyOptions,omitempty"`
+}
+
+// Group is a group of properties
+type Group struct {
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	DisplayOrder int64  `json:"displayOrder,omitempty"`
+	Archived     bool   `json:"archived,omitempty"`
+}
+
+// GroupUpdate holds the changes to a property group. Only the fields that are set are sent.
+type GroupUpdate struct {
+	Label        string `json:"label,omitempty"`
+	DisplayOrder *int64 `json:"displayOrder,omitempty"`
+}
+
+func unmarshalHubSpotProperties(data []byte) (HubSpotProperties, error) {
+	var r HubSpotProperties
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalProperty(data []byte) (Property, error) {
+	var r Property
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalHubSpotGroups(data []byte) (HubSpotGroups, error) {
+	var r HubSpotGroups
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalGroup(data []byte) (Group, error) {
+	var r Group
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,27 @@
+// Package properties covers the CRM Properties API, which is used to manage the properties that store data on
+// contacts, companies, deals, tickets, products, line items, quotes and custom objects, and the groups the
+// properties are organized in.
+package properties
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePayloads(t *testing.T) {
+	payload, err := json.Marshal(PropertyUpdate{Label: "Favourite colour"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"label":"Favourite colour"}`, string(payload))
+
+	hidden := false
+	order := int64(0)
+	payload, err = json.Marshal(PropertyUpdate{Hidden: &hidden, DisplayOrder: &order})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"hidden":false,"displayOrder":0}`, string(payload))
+
+	payload, err = json.Marshal(GroupUpdate{Label: "Contact details"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"label":"Contact details"}`, string(payload))
+}
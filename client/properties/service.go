@@ -0,0 +1,192 @@
+// Package properties covers the CRM Properties API, which is used to manage the properties that store data on
+// contacts, companies, deals, tickets, products, line items, quotes and custom objects, and the groups the
+// properties are organized in.
+package properties
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+const (
+	// propertiesEndpoint is the endpoint to list and create properties
+	propertiesEndpoint = "crm/v3/properties/%s"
+	// propertyEndpoint is the endpoint to get, update and delete a single property
+	propertyEndpoint = "crm/v3/properties/%s/%s"
+	// groupsEndpoint is the endpoint to list and create property groups
+	groupsEndpoint = "crm/v3/properties/%s/groups"
+	// groupEndpoint is the endpoint to get, update and delete a single property group
+	groupEndpoint = "crm/v3/properties/%s/groups/%s"
+)
+
+// Properties contains the elements to communicate with the HubSpot CRM Properties endpoints for one type of object.
+type Properties struct {
+	*client.Client
+	ObjectType crm.ObjectType
+	Archived   bool
+}
+
+// New creates a new instance of the Properties service for the given object type with default settings. For
+// custom objects the object type is the fully qualified name or the object type ID of the custom object.
+func New(c *client.Client, objectType crm.ObjectType) *Properties {
+	return &Properties{
+		c, objectType, false,
+	}
+}
+
+// WithArchived sets whether archived properties are returned instead of active ones, returning a Properties
+// pointer for chaining.
+func (p *Properties) WithArchived(archived bool) *Properties {
+	p.Archived = archived
+	return p
+}
+
+// GetAllProperties gets the definitions of all properties of the object type.
+func (p *Properties) GetAllProperties() ([]Property, error) {
+	url := buildURL(p, fmt.Sprintf(propertiesEndpoint, p.ObjectType), true)
+
+	res, err := p.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotProperties(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Results, nil
+}
+
+// GetProperty gets the definition of a single property by its name.
+func (p *Properties) GetProperty(name string) (Property, error) {
+	url := buildURL(p, fmt.Sprintf(propertyEndpoint, p.ObjectType, name), true)
+
+	res, err := p.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Property{}, err
+	}
+
+	return unmarshalProperty(res)
+}
+
+// CreateProperty creates a property. The name, label, type, field type and group name are required.
+func (p *Properties) CreateProperty(property Property) (Property, error) {
+	url := buildURL(p, fmt.Sprintf(propertiesEndpoint, p.ObjectType), false)
+
+	return p.sendProperty(url, http.MethodPost, property)
+}
+
+// UpdateProperty updates the definition of the property with the given name. Only the fields set in the update
+// are changed. The name of a property can't be changed.
+func (p *Properties) UpdateProperty(name string, update PropertyUpdate) (Property, error) {
+	url := buildURL(p, fmt.Sprintf(propertyEndpoint, p.ObjectType, name), false)
+
+	return p.sendProperty(url, http.MethodPatch, update)
+}
+
+// DeleteProperty archives a property by its name.
+func (p *Properties) DeleteProperty(name string) error {
+	url := buildURL(p, fmt.Sprintf(propertyEndpoint, p.ObjectType, name), false)
+
+	_, err := p.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// GetAllGroups gets all property groups of the object type.
+func (p *Properties) GetAllGroups() ([]Group, error) {
+	url := buildURL(p, fmt.Sprintf(groupsEndpoint, p.ObjectType), true)
+
+	res, err := p.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotGroups(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Results, nil
+}
+
+// GetGroup gets a single property group by its name.
+func (p *Properties) GetGroup(name string) (Group, error) {
+	url := buildURL(p, fmt.Sprintf(groupEndpoint, p.ObjectType, name), true)
+
+	res, err := p.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return unmarshalGroup(res)
+}
+
+// CreateGroup creates a property group.
+func (p *Properties) CreateGroup(group Group) (Group, error) {
+	url := buildURL(p, fmt.Sprintf(groupsEndpoint, p.ObjectType), false)
+
+	return p.sendGroup(url, http.MethodPost, group)
+}
+
+// UpdateGroup updates the label and display order of the property group with the given name. Only the fields set
+// in the update are changed.
+func (p *Properties) UpdateGroup(name string, update GroupUpdate) (Group, error) {
+	url := buildURL(p, fmt.Sprintf(groupEndpoint, p.ObjectType, name), false)
+
+	return p.sendGroup(url, http.MethodPatch, update)
+}
+
+// DeleteGroup archives a property group by its name.
+func (p *Properties) DeleteGroup(name string) error {
+	url := buildURL(p, fmt.Sprintf(groupEndpoint, p.ObjectType, name), false)
+
+	_, err := p.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// sendProperty marshals the property or update, sends it and unmarshals the property that HubSpot returns.
+func (p *Properties) sendProperty(url string, httpMethod string, property interface{}) (Property, error) {
+	payload, err := json.Marshal(property)
+	if err != nil {
+		return Property{}, err
+	}
+
+	res, err := p.Call(url, httpMethod, payload)
+	if err != nil {
+		return Property{}, err
+	}
+
+	return unmarshalProperty(res)
+}
+
+// sendGroup marshals the group or update, sends it and unmarshals the group that HubSpot returns.
+func (p *Properties) sendGroup(url string, httpMethod string, group interface{}) (Group, error) {
+	payload, err := json.Marshal(group)
+	if err != nil {
+		return Group{}, err
+	}
+
+	res, err := p.Call(url, httpMethod, payload)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return unmarshalGroup(res)
+}
+
+// Construct the proper URL to call
+func buildURL(p *Properties, u string, read bool) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, p.APIKey)
+
+	if read && p.Archived {
+		url = fmt.Sprintf("%s&archived=true", url)
+	}
+
+	return url
+}
@@ -0,0 +1,252 @@
+// Package properties covers the CRM Properties API, which is used to manage the properties that store data on
+// contacts, companies, deals, tickets, products, line items, quotes and custom objects, and the groups the
+// properties are organized in.
+package properties
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+const (
+	defaultCacheTTL = time.Hour
+	// multiValueSeparator separates the selected options of a checkbox property
+	multiValueSeparator = ";"
+	// millisPerDay is the number of milliseconds in a day, used to check that dates are at midnight UTC
+	millisPerDay = 24 * 60 * 60 * 1000
+)
+
+// FieldError is the reason a single property value can't be written
+type FieldError struct {
+	Property string
+	Value    string
+	Reason   string
+}
+
+// Error returns the field error as a string
+func (e FieldError) Error() string {
+	return fmt.Sprintf("property %s with value %q: %s", e.Property, e.Value, e.Reason)
+}
+
+// ValidationError contains all problems found while validating property values
+type ValidationError struct {
+	ObjectType crm.ObjectType
+	Fields     []FieldError
+}
+
+// Error returns the validation error as a string
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0)
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Error())
+	}
+	return fmt.Sprintf("invalid %s properties: %s", e.ObjectType, strings.Join(msgs, "; "))
+}
+
+// cacheKey identifies the property definitions of one object type in one portal
+type cacheKey struct {
+	apiKey     string
+	objectType crm.ObjectType
+}
+
+type cacheEntry struct {
+	properties map[string]Property
+	loadedAt   time.Time
+}
+
+// Validator checks property values against the property definitions of a portal before they are sent to HubSpot.
+// The definitions are loaded the first time an object type is validated and cached per portal. A Validator can be
+// shared between services and goroutines.
+type Validator struct {
+	*client.Client
+	TTL   time.Duration
+	mu    sync.Mutex
+	cache map[cacheKey]cacheEntry
+}
+
+// NewValidator creates a new instance of the Validator with default settings.
+func NewValidator(c *client.Client) *Validator {
+	return &Validator{
+		Client: c,
+		TTL:    defaultCacheTTL,
+		cache:  make(map[cacheKey]cacheEntry),
+	}
+}
+
+// WithTTL sets how long property definitions are cached, returning a Validator pointer for chaining.
+func (v *Validator) WithTTL(ttl time.Duration) *Validator {
+	v.TTL = ttl
+	return v
+}
+
+// Reset clears the cached property definitions, so they are loaded again on the next validation.
+func (v *Validator) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.cache = make(map[cacheKey]cacheEntry)
+}
+
+// Validate checks the names and values of the properties that will be written to an object of the given type
+// through the v1 APIs, like contacts.UpdateContact and deals.UpdateDeal. Those APIs only take date and datetime
+// values as milliseconds since epoch, with dates at midnight UTC. It returns a *ValidationError listing every
+// invalid property, or nil when all values can be written.
+func (v *Validator) Validate(objectType crm.ObjectType, props map[string]string) error {
+	return v.validate(objectType, props, false)
+}
+
+// ValidateV3 checks property values the same way as Validate, for writes through the v3 APIs like the crm package.
+// Those APIs also take dates as YYYY-MM-DD and datetimes as RFC 3339 timestamps.
+func (v *Validator) ValidateV3(objectType crm.ObjectType, props map[string]string) error {
+	return v.validate(objectType, props, true)
+}
+
+func (v *Validator) validate(objectType crm.ObjectType, props map[string]string, iso bool) error {
+	definitions, err := v.definitions(objectType)
+	if err != nil {
+		return err
+	}
+
+	fields := validateProperties(definitions, props, iso)
+	if len(fields) > 0 {
+		return &ValidationError{ObjectType: objectType, Fields: fields}
+	}
+
+	return nil
+}
+
+// definitions returns the cached property definitions of the object type, loading them when needed.
+func (v *Validator) definitions(objectType crm.ObjectType) (map[string]Property, error) {
+	key := cacheKey{apiKey: v.APIKey, objectType: objectType}
+
+	v.mu.Lock()
+	entry, ok := v.cache[key]
+	v.mu.Unlock()
+
+	if ok && time.Since(entry.loadedAt) < v.TTL {
+		return entry.properties, nil
+	}
+
+	props, err := New(v.Client, objectType).GetAllProperties()
+	if err != nil {
+		return nil, err
+	}
+
+	entry = cacheEntry{
+		properties: make(map[string]Property),
+		loadedAt:   time.Now(),
+	}
+	for _, p := range props {
+		entry.properties[p.Name] = p
+	}
+
+	v.mu.Lock()
+	v.cache[key] = entry
+	v.mu.Unlock()
+
+	return entry.properties, nil
+}
+
+// validateProperties checks the property values against the definitions and returns the problems found, sorted
+// by property name. When iso is true, ISO 8601 dates and datetimes are accepted as well.
+func validateProperties(definitions map[string]Property, props map[string]string, iso bool) []FieldError {
+	fields := make([]FieldError, 0)
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := props[name]
+		def, ok := definitions[name]
+		if !ok {
+			fields = append(fields, FieldError{Property: name, Value: value, Reason: "unknown property"})
+			continue
+		}
+
+		if def.Calculated || (def.ModificationMetadata != nil && def.ModificationMetadata.ReadOnlyValue) {
+			fields = append(fields, FieldError{Property: name, Value: value, Reason: "property is read-only"})
+			continue
+		}
+
+		// An empty value clears the property
+		if value == "" {
+			continue
+		}
+
+		if reason := validateValue(def, value, iso); reason != "" {
+			fields = append(fields, FieldError{Property: name, Value: value, Reason: reason})
+		}
+	}
+
+	return fields
+}
+
+// validateValue returns why the value doesn't fit the property, or an empty string when it does.
+func validateValue(def Property, value string, iso bool) string {
+	switch def.Type {
+	case TypeNumber:
+		if !client.IsDecimal(value) {
+			return "not a number"
+		}
+	case TypeBool:
+		if value != "true" && value != "false" {
+			return "not true or false"
+		}
+	case TypeDate:
+		if iso {
+			if _, err := time.Parse("2006-01-02", value); err == nil {
+				return ""
+			}
+		}
+		millis, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			if iso {
+				return "not a date (YYYY-MM-DD or milliseconds since epoch)"
+			}
+			return "not a date (milliseconds since epoch)"
+		}
+		if millis%millisPerDay != 0 {
+			return "not midnight UTC"
+		}
+	case TypeDateTime:
+		if iso {
+			if _, err := time.Parse(time.RFC3339, value); err == nil {
+				return ""
+			}
+		}
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			if iso {
+				return "not a datetime (RFC 3339 or milliseconds since epoch)"
+			}
+			return "not a datetime (milliseconds since epoch)"
+		}
+	case TypeEnumeration:
+		if def.ExternalOptions {
+			return ""
+		}
+		options := make(map[string]bool)
+		for _, o := range def.Options {
+			options[o.Value] = true
+		}
+		values := []string{value}
+		if def.FieldType == FieldTypeCheckbox {
+			values = strings.Split(value, multiValueSeparator)
+		}
+		for _, val := range values {
+			if !options[val] {
+				return fmt.Sprintf("%q is not one of the options", val)
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,65 @@
+// Package properties covers the CRM Properties API, which is used to manage the properties that store data on
+// contacts, companies, deals, tickets, products, line items, quotes and custom objects, and the groups the
+// properties are organized in.
+package properties
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateProperties(t *testing.T) {
+	definitions := map[string]Property{
+		"amount":    {Name: "amount", Type: TypeNumber},
+		"closedate": {Name: "closedate", Type: TypeDate},
+		"lastseen":  {Name: "lastseen", Type: TypeDateTime},
+		"dealstage": {Name: "dealstage", Type: TypeEnumeration, FieldType: FieldTypeSelect, Options: []Option{{Value: "appointmentscheduled"}, {Value: "closedwon"}}},
+		"channels":  {Name: "channels", Type: TypeEnumeration, FieldType: FieldTypeCheckbox, Options: []Option{{Value: "web"}, {Value: "phone"}}},
+		"days":      {Name: "days", Type: TypeNumber, Calculated: true},
+	}
+
+	valid := map[string]string{
+		"amount":    "1500.50",
+		"closedate": "1557964800000",
+		"lastseen":  "1557964812345",
+		"dealstage": "closedwon",
+		"channels":  "web;phone",
+	}
+	assert.Empty(t, validateProperties(definitions, valid, false))
+	assert.Empty(t, validateProperties(definitions, valid, true))
+
+	iso := map[string]string{
+		"closedate": "2019-05-16",
+		"lastseen":  "2019-05-16T15:04:05Z",
+	}
+	assert.Empty(t, validateProperties(definitions, iso, true))
+	assert.Len(t, validateProperties(definitions, iso, false), 2)
+
+	for _, number := range []string{"NaN", "Inf", "-Infinity", "0x1p-2", "1e3", "1,5", "."} {
+		assert.Len(t, validateProperties(definitions, map[string]string{"amount": number}, false), 1, number)
+	}
+	for _, number := range []string{"42", "-1.5", "+.5", "10."} {
+		assert.Empty(t, validateProperties(definitions, map[string]string{"amount": number}, false), number)
+	}
+
+	invalid := map[string]string{
+		"amount":    "lots",
+		"closedate": "1557964800001",
+		"dealstage": "won",
+		"channels":  "web;fax",
+		"days":      "3",
+		"dealnme":   "typo",
+	}
+	fields := validateProperties(definitions, invalid, false)
+	assert.Len(t, fields, 6)
+
+	names := make([]string, 0)
+	for _, f := range fields {
+		names = append(names, f.Property)
+	}
+	assert.Equal(t, []string{"amount", "channels", "closedate", "days", "dealnme", "dealstage"}, names)
+
+	err := &ValidationError{ObjectType: "deals", Fields: fields[:1]}
+	assert.Contains(t, err.Error(), "invalid deals properties")
+}
@@ -0,0 +1,256 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// multiValueSeparator separates the selected options of a multi-select property
+	multiValueSeparator = ";"
+	// maxDecimalPlaces is the number of decimal places used for decimals that can't be written exactly, like 1/3
+	maxDecimalPlaces = 10
+)
+
+// decimalPattern matches plain decimal numbers, the format HubSpot uses for number properties
+var decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+// PropertyValue is the value of a single property of a contact, deal, ticket or any other object, with its
+// history when HubSpot returns it. The getters convert the value to Go types and the setters format Go values
+// the way HubSpot expects them on writes.
+type PropertyValue struct {
+	Value     string            `json:"value"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Source    string            `json:"source,omitempty"`
+	SourceID  string            `json:"sourceId,omitempty"`
+	Versions  []PropertyVersion `json:"versions,omitempty"`
+}
+
+// PropertyVersion is a previous value of a property
+type PropertyVersion struct {
+	Name        string        `json:"name,omitempty"`
+	Value       string        `json:"value"`
+	Timestamp   int64         `json:"timestamp"`
+	Source      string        `json:"source,omitempty"`
+	SourceID    string        `json:"sourceId,omitempty"`
+	SourceLabel string        `json:"sourceLabel,omitempty"`
+	Selected    bool          `json:"selected,omitempty"`
+	SourceVid   []interface{} `json:"sourceVid,omitempty"`
+	RequestID   string        `json:"requestId,omitempty"`
+}
+
+// UnmarshalJSON decodes a property value from any of the HubSpot APIs. The contacts API uses hyphenated keys
+// (like source-id) where the other APIs use camel case, and some APIs send numbers or booleans instead of strings.
+func (p *PropertyValue) UnmarshalJSON(data []byte) error {
+	var temp struct {
+		Value     json.RawMessage `json:"value"`
+		Timestamp int64           `json:"timestamp"`
+		Source    string          `json:"source"`
+		SourceID  json.RawMessage `json:"sourceId"`
+		Versions  []struct {
+			Name           string          `json:"name"`
+			Value          json.RawMessage `json:"value"`
+			Timestamp      int64           `json:"timestamp"`
+			Source         string          `json:"source"`
+			SourceType     string          `json:"source-type"`
+			SourceID       json.RawMessage `json:"sourceId"`
+			HyphenSourceID json.RawMessage `json:"source-id"`
+			SourceLabel    string          `json:"source-label"`
+			Selected       bool            `json:"selected"`
+			SourceVid      []interface{}   `json:"sourceVid"`
+			RequestID      string          `json:"requestId"`
+		} `json:"versions"`
+	}
+
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	p.Value = rawString(temp.Value)
+	p.Timestamp = temp.Timestamp
+	p.Source = temp.Source
+	p.SourceID = rawString(temp.SourceID)
+	p.Versions = nil
+
+	for _, v := range temp.Versions {
+		version := PropertyVersion{
+			Name:        v.Name,
+			Value:       rawString(v.Value),
+			Timestamp:   v.Timestamp,
+			Source:      v.Source,
+			SourceID:    rawString(v.SourceID),
+			SourceLabel: v.SourceLabel,
+			Selected:    v.Selected,
+			SourceVid:   v.SourceVid,
+			RequestID:   v.RequestID,
+		}
+		if version.Source == "" {
+			version.Source = v.SourceType
+		}
+		if version.SourceID == "" {
+			version.SourceID = rawString(v.HyphenSourceID)
+		}
+		p.Versions = append(p.Versions, version)
+	}
+
+	return nil
+}
+
+// rawString returns a JSON string without quotes, and any other JSON value as it was sent. null becomes an empty string.
+func rawString(data json.RawMessage) string {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s
+	}
+
+	return string(data)
+}
+
+// IsSet returns true when the property has a value
+func (p PropertyValue) IsSet() bool {
+	return p.Value != ""
+}
+
+// String returns the value as it was received from HubSpot
+func (p PropertyValue) String() string {
+	return p.Value
+}
+
+// Int64 returns the value of a number property as an int64
+func (p PropertyValue) Int64() (int64, error) {
+	return strconv.ParseInt(p.Value, 10, 64)
+}
+
+// Float returns the value of a number property as a float64
+func (p PropertyValue) Float() (float64, error) {
+	return strconv.ParseFloat(p.Value, 64)
+}
+
+// Decimal returns the value of a number property as an exact decimal. Use it instead of Float for prices,
+// quantities and amounts, which can't always be represented exactly as a float64. Only plain decimal numbers like
+// "19.99" or "-3" are accepted, not fractions or exponents.
+func (p PropertyValue) Decimal() (*big.Rat, error) {
+	if !IsDecimal(p.Value) {
+		return nil, fmt.Errorf("invalid decimal value %q", p.Value)
+	}
+
+	d, ok := new(big.Rat).SetString(p.Value)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal value %q", p.Value)
+	}
+	return d, nil
+}
+
+// Bool returns the value of a bool property
+func (p PropertyValue) Bool() (bool, error) {
+	return strconv.ParseBool(p.Value)
+}
+
+// Time returns the value of a date or datetime property. HubSpot sends these as milliseconds since epoch, and the
+// v3 APIs as RFC 3339 timestamps or dates.
+func (p PropertyValue) Time() (time.Time, error) {
+	millis, err := strconv.ParseInt(p.Value, 10, 64)
+	if err == nil {
+		return time.Unix(0, millis*int64(time.Millisecond)).UTC(), nil
+	}
+
+	if t, err := time.Parse("2006-01-02", p.Value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, p.Value)
+}
+
+// Enum returns the internal value of the selected option of an enumeration property
+func (p PropertyValue) Enum() string {
+	return p.Value
+}
+
+// Multi returns the internal values of the selected options of a multi-select (checkbox) property
+func (p PropertyValue) Multi() []string {
+	if p.Value == "" {
+		return []string{}
+	}
+	return strings.Split(p.Value, multiValueSeparator)
+}
+
+// SetString sets the value of a text or enumeration property
+func (p *PropertyValue) SetString(s string) {
+	p.Value = s
+}
+
+// SetInt64 sets the value of a number property
+func (p *PropertyValue) SetInt64(i int64) {
+	p.Value = strconv.FormatInt(i, 10)
+}
+
+// SetFloat sets the value of a number property
+func (p *PropertyValue) SetFloat(f float64) {
+	p.Value = strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// IsDecimal reports whether s is a plain decimal number like "19.99" or "-3", the format HubSpot uses for number
+// properties. Unlike strconv.ParseFloat it doesn't accept NaN, infinities, exponents or hexadecimal floats.
+func IsDecimal(s string) bool {
+	return decimalPattern.MatchString(s)
+}
+
+// SetDecimal sets the value of a number property from an exact decimal. A nil decimal clears the value.
+func (p *PropertyValue) SetDecimal(d *big.Rat) {
+	p.Value = FormatDecimal(d)
+}
+
+// SetBool sets the value of a bool property
+func (p *PropertyValue) SetBool(b bool) {
+	p.Value = strconv.FormatBool(b)
+}
+
+// SetTime sets the value of a datetime property as milliseconds since epoch
+func (p *PropertyValue) SetTime(t time.Time) {
+	p.Value = strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// SetDate sets the value of a date property. HubSpot only accepts dates at midnight UTC, so the time of day is
+// dropped.
+func (p *PropertyValue) SetDate(t time.Time) {
+	y, m, d := t.Date()
+	p.SetTime(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// SetMulti sets the selected options of a multi-select (checkbox) property
+func (p *PropertyValue) SetMulti(values []string) {
+	p.Value = strings.Join(values, multiValueSeparator)
+}
+
+// FormatDecimal formats a decimal the way HubSpot expects number properties, using as few decimal places as
+// needed to write the value exactly. Values that don't have an exact decimal representation are rounded to
+// 10 decimal places. A nil decimal is formatted as an empty string.
+func FormatDecimal(d *big.Rat) string {
+	if d == nil {
+		return ""
+	}
+
+	if d.IsInt() {
+		return d.FloatString(0)
+	}
+
+	for places := 1; places < maxDecimalPlaces; places++ {
+		s := d.FloatString(places)
+		if r, ok := new(big.Rat).SetString(s); ok && r.Cmp(d) == 0 {
+			return s
+		}
+	}
+
+	return d.FloatString(maxDecimalPlaces)
+}
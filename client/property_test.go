@@ -0,0 +1,55 @@
+package client
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyValue(t *testing.T) {
+	var contact map[string]PropertyValue
+	err := json.Unmarshal([]byte(`{
+		"firstname": {"value": "Codey", "versions": [{"value": "Codey", "source-type": "API", "source-id": null, "timestamp": 1557964800000, "selected": false}]},
+		"num_employees": {"value": 42},
+		"closedate": {"value": "1557964800000", "timestamp": 1557964800000, "source": "CRM_UI", "sourceId": "user@example.com"}
+	}`), &contact)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Codey", contact["firstname"].String())
+	assert.Equal(t, "API", contact["firstname"].Versions[0].Source)
+
+	employees, err := contact["num_employees"].Int64()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), employees)
+
+	closedate, err := contact["closedate"].Time()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 5, 16, 0, 0, 0, 0, time.UTC), closedate)
+	assert.Equal(t, "user@example.com", contact["closedate"].SourceID)
+
+	var p PropertyValue
+	p.SetDate(time.Date(2019, 5, 16, 15, 4, 5, 0, time.UTC))
+	assert.Equal(t, "1557964800000", p.Value)
+	p.SetFloat(1500.5)
+	assert.Equal(t, "1500.5", p.Value)
+	p.SetMulti([]string{"web", "phone"})
+	assert.Equal(t, []string{"web", "phone"}, p.Multi())
+
+	price, err := PropertyValue{Value: "19.99"}.Decimal()
+	assert.NoError(t, err)
+	total := new(big.Rat).Mul(price, big.NewRat(3, 1))
+	p.SetDecimal(total)
+	assert.Equal(t, "59.97", p.Value)
+	assert.Equal(t, "0.3333333333", FormatDecimal(big.NewRat(1, 3)))
+	assert.Equal(t, "-2", FormatDecimal(big.NewRat(-4, 2)))
+	assert.Equal(t, "", FormatDecimal(nil))
+	p.SetDecimal(nil)
+	assert.False(t, p.IsSet())
+	for _, value := range []string{"abc", "1/3", "1e3", "NaN", ""} {
+		_, err = PropertyValue{Value: value}.Decimal()
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,111 @@
+// Package quotes covers the quotes sent to customers, with the deals and line items they are associated with.
+package quotes
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/retgits/hubspot/client/crm"
+)
+
+const (
+	// PropertyTitle is the title of the quote
+	PropertyTitle = "hs_title"
+	// PropertyStatus is the approval status of the quote
+	PropertyStatus = "hs_status"
+	// PropertyExpirationDate is the date the quote expires
+	PropertyExpirationDate = "hs_expiration_date"
+	// PropertyAmount is the total amount of the quote, calculated by HubSpot
+	PropertyAmount = "hs_quote_amount"
+	// PropertyCurrency is the currency code of the quote
+	PropertyCurrency = "hs_currency"
+	// PropertyPublicURLKey is the key of the public URL of the quote
+	PropertyPublicURLKey = "hs_public_url_key"
+)
+
+const (
+	// dealsAssociation is the key of the deals associated with a quote
+	dealsAssociation = "deals"
+	// lineItemsAssociation is the key of the line items associated with a quote
+	lineItemsAssociation = "line_items"
+)
+
+// defaultProperties are the properties returned for each quote unless WithProperties is used
+var defaultProperties = []string{
+	PropertyTitle, PropertyStatus, PropertyExpirationDate, PropertyAmount, PropertyCurrency, PropertyPublicURLKey,
+}
+
+// Quote is a single quote
+type Quote struct {
+	crm.Object
+}
+
+// QuoteList is a single page of quotes
+type QuoteList struct {
+	Results []Quote
+	// After is the cursor of the next page, or an empty string when this is the last page
+	After string
+}
+
+// Title returns the title of the quote
+func (q *Quote) Title() string {
+	return q.Property(PropertyTitle).String()
+}
+
+// Status returns the approval status of the quote, like DRAFT or APPROVED
+func (q *Quote) Status() string {
+	return q.Property(PropertyStatus).Enum()
+}
+
+// ExpirationDate returns the date the quote expires
+func (q *Quote) ExpirationDate() (time.Time, error) {
+	return q.Property(PropertyExpirationDate).Time()
+}
+
+// Amount returns the total amount of the quote as an exact decimal
+func (q *Quote) Amount() (*big.Rat, error) {
+	return q.Property(PropertyAmount).Decimal()
+}
+
+// Currency returns the currency code of the quote
+func (q *Quote) Currency() string {
+	return q.Property(PropertyCurrency).String()
+}
+
+// DealIDs returns the IDs of the deals associated with the quote
+func (q *Quote) DealIDs() []string {
+	return q.associated(dealsAssociation)
+}
+
+// LineItemIDs returns the IDs of the line items on the quote
+func (q *Quote) LineItemIDs() []string {
+	return q.associated(lineItemsAssociation)
+}
+
+func (q *Quote) associated(objectType string) []string {
+	ids := make([]string, 0)
+
+	list, ok := q.Associations[objectType]
+	if !ok {
+		return ids
+	}
+
+	// Associations are listed once per association type, so the same object can show up more than once
+	seen := make(map[string]bool)
+	for _, result := range list.Results {
+		if !seen[result.ID] {
+			seen[result.ID] = true
+			ids = append(ids, result.ID)
+		}
+	}
+
+	return ids
+}
+
+func newQuotes(objects []crm.Object) []Quote {
+	quotes := make([]Quote, 0)
+	for idx := range objects {
+		quotes = append(quotes, Quote{objects[idx]})
+	}
+	return quotes
+}
@@ -0,0 +1,35 @@
+// Package quotes covers the quotes sent to customers, with the deals and line items they are associated with.
+package quotes
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuote(t *testing.T) {
+	var q Quote
+	err := json.Unmarshal([]byte(`{
+		"id": "101",
+		"properties": {"hs_title": "Renewal", "hs_status": "APPROVED", "hs_quote_amount": "1234.10", "hs_expiration_date": "2020-03-31"},
+		"associations": {
+			"deals": {"results": [{"id": "7", "type": "quote_to_deal"}]},
+			"line_items": {"results": [{"id": "11", "type": "quote_to_line_item"}, {"id": "12", "type": "quote_to_line_item"}, {"id": "11", "type": "quote_to_line_item_unlabeled"}]}
+		}
+	}`), &q)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "101", q.ID)
+	assert.Equal(t, "Renewal", q.Title())
+	assert.Equal(t, "APPROVED", q.Status())
+	amount, err := q.Amount()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, amount.Cmp(big.NewRat(123410, 100)))
+	assert.Equal(t, []string{"7"}, q.DealIDs())
+	assert.Equal(t, []string{"11", "12"}, q.LineItemIDs())
+
+	var empty Quote
+	assert.Equal(t, []string{}, empty.DealIDs())
+}
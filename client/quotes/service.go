@@ -0,0 +1,72 @@
+// Package quotes covers the quotes sent to customers, with the deals and line items they are associated with.
+package quotes
+
+import (
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/retgits/hubspot/client/lineitems"
+)
+
+// Quotes contains the elements to communicate with the HubSpot quotes endpoints. Quotes are read-only, they are
+// created and sent from HubSpot.
+type Quotes struct {
+	*crm.Objects
+	LineItems *lineitems.LineItems
+}
+
+// New creates a new instance of the Quotes service with default settings. The IDs of the associated deals and
+// line items are returned with each quote.
+func New(c *client.Client) *Quotes {
+	return &Quotes{
+		crm.New(c, crm.Quotes).WithProperties(defaultProperties).WithAssociations([]string{dealsAssociation, lineItemsAssociation}),
+		lineitems.New(c),
+	}
+}
+
+// WithLimit sets the number of quotes returned per page, returning a Quotes pointer for chaining.
+func (q *Quotes) WithLimit(limit int64) *Quotes {
+	q.Objects.WithLimit(limit)
+	return q
+}
+
+// WithProperties sets the properties returned for each quote, returning a Quotes pointer for chaining.
+func (q *Quotes) WithProperties(props []string) *Quotes {
+	q.Objects.WithProperties(props)
+	return q
+}
+
+// GetQuote gets a single quote by its ID.
+func (q *Quotes) GetQuote(quoteID string) (Quote, error) {
+	object, err := q.GetObject(quoteID)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	return Quote{object}, nil
+}
+
+// ListQuotes gets a single page of quotes. Pass an empty after to get the first page, and the After of the
+// returned list to get the next one.
+func (q *Quotes) ListQuotes(after string) (QuoteList, error) {
+	temp, err := q.ListObjects(after)
+	if err != nil {
+		return QuoteList{}, err
+	}
+
+	return QuoteList{Results: newQuotes(temp.Results), After: temp.After()}, nil
+}
+
+// GetAllQuotes gets all quotes of the portal.
+func (q *Quotes) GetAllQuotes() ([]Quote, error) {
+	objects, err := q.GetAllObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	return newQuotes(objects), nil
+}
+
+// GetQuoteLineItems gets the line items on a quote.
+func (q *Quotes) GetQuoteLineItems(quote Quote) ([]lineitems.LineItem, error) {
+	return q.LineItems.BatchGetLineItems(quote.LineItemIDs())
+}
@@ -0,0 +1,101 @@
+// Package schemas covers the CRM Schemas API, which is used to manage the definitions of custom objects: their
+// properties and the association types between them and other objects. The records of a custom object are read
+// and written with the crm package.
+package schemas
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/retgits/hubspot/client/properties"
+)
+
+// HubSpotSchemas is the payload returned after listing schemas
+type HubSpotSchemas struct {
+	Results []Schema `json:"results"`
+}
+
+// Schema is the definition of a custom object
+type Schema struct {
+	ID                         string                  `json:"id"`
+	Name                       string                  `json:"name"`
+	Labels                     Labels                  `json:"labels"`
+	ObjectTypeID               string                  `json:"objectTypeId"`
+	FullyQualifiedName         string                  `json:"fullyQualifiedName"`
+	MetaType                   string                  `json:"metaType,omitempty"`
+	PrimaryDisplayProperty     string                  `json:"primaryDisplayProperty"`
+	SecondaryDisplayProperties []string                `json:"secondaryDisplayProperties"`
+	RequiredProperties         []string                `json:"requiredProperties"`
+	SearchableProperties       []string                `json:"searchableProperties"`
+	Properties                 []Property              `json:"properties"`
+	Associations               []AssociationDefinition `json:"associations"`
+	CreatedAt                  time.Time               `json:"createdAt"`
+	UpdatedAt                  time.Time               `json:"updatedAt"`
+	Archived                   bool                    `json:"archived"`
+}
+
+// Labels are the names of a custom object shown in HubSpot
+type Labels struct {
+	Singular string `json:"singular"`
+	Plural   string `json:"plural"`
+}
+
+// Property is a property of a custom object
+type Property = properties.Property
+
+// PropertyUpdate holds the changes to a property of a custom object
+type PropertyUpdate = properties.PropertyUpdate
+
+// Option is a possible value of an enumeration property of a custom object
+type Option = properties.Option
+
+// AssociationDefinition is an association type between a custom object and another object type
+type AssociationDefinition struct {
+	ID               string     `json:"id,omitempty"`
+	FromObjectTypeID string     `json:"fromObjectTypeId"`
+	ToObjectTypeID   string     `json:"toObjectTypeId"`
+	Name             string     `json:"name,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
+// SchemaInput is the payload sent to create a custom object. AssociatedObjects lists the object types (like
+// CONTACT or the fully qualified name of another custom object) the custom object can be associated with.
+type SchemaInput struct {
+	Name                       string     `json:"name"`
+	Labels                     Labels     `json:"labels"`
+	PrimaryDisplayProperty     string     `json:"primaryDisplayProperty,omitempty"`
+	SecondaryDisplayProperties []string   `json:"secondaryDisplayProperties,omitempty"`
+	RequiredProperties         []string   `json:"requiredProperties"`
+	SearchableProperties       []string   `json:"searchableProperties,omitempty"`
+	Properties                 []Property `json:"properties"`
+	AssociatedObjects          []string   `json:"associatedObjects"`
+}
+
+// SchemaUpdate is the payload sent to update a custom object. Only the fields that are set are changed.
+type SchemaUpdate struct {
+	Labels                     *Labels  `json:"labels,omitempty"`
+	PrimaryDisplayProperty     string   `json:"primaryDisplayProperty,omitempty"`
+	SecondaryDisplayProperties []string `json:"secondaryDisplayProperties,omitempty"`
+	RequiredProperties         []string `json:"requiredProperties,omitempty"`
+	SearchableProperties       []string `json:"searchableProperties,omitempty"`
+	Restorable                 *bool    `json:"restorable,omitempty"`
+}
+
+func unmarshalHubSpotSchemas(data []byte) (HubSpotSchemas, error) {
+	var r HubSpotSchemas
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalSchema(data []byte) (Schema, error) {
+	var r Schema
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalAssociationDefinition(data []byte) (AssociationDefinition, error) {
+	var r AssociationDefinition
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
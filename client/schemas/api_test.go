@@ -0,0 +1,41 @@
+// Package schemas covers the CRM Schemas API, which is used to manage the definitions of custom objects: their
+// properties and the association types between them and other objects. The records of a custom object are read
+// and written with the crm package.
+package schemas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaPayloads(t *testing.T) {
+	input := SchemaInput{
+		Name:                   "car",
+		Labels:                 Labels{Singular: "Car", Plural: "Cars"},
+		PrimaryDisplayProperty: "model",
+		RequiredProperties:     []string{"model"},
+		Properties:             []Property{{Name: "model", Label: "Model", Type: "string", FieldType: "text"}},
+		AssociatedObjects:      []string{"CONTACT"},
+	}
+	payload, err := json.Marshal(input)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name":"car",
+		"labels":{"singular":"Car","plural":"Cars"},
+		"primaryDisplayProperty":"model",
+		"requiredProperties":["model"],
+		"properties":[{"name":"model","label":"Model","type":"string","fieldType":"text"}],
+		"associatedObjects":["CONTACT"]
+	}`, string(payload))
+
+	restorable := false
+	payload, err = json.Marshal(SchemaUpdate{Restorable: &restorable})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"restorable":false}`, string(payload))
+
+	payload, err = json.Marshal(AssociationDefinition{FromObjectTypeID: "2-123", ToObjectTypeID: "0-1"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fromObjectTypeId":"2-123","toObjectTypeId":"0-1"}`, string(payload))
+}
@@ -0,0 +1,184 @@
+// Package schemas covers the CRM Schemas API, which is used to manage the definitions of custom objects: their
+// properties and the association types between them and other objects. The records of a custom object are read
+// and written with the crm package.
+package schemas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/retgits/hubspot/client/properties"
+)
+
+const (
+	// schemasEndpoint is the endpoint to list and create schemas
+	schemasEndpoint = "crm/v3/schemas"
+	// schemaEndpoint is the endpoint to get, update and delete a single schema
+	schemaEndpoint = "crm/v3/schemas/%s"
+	// associationsEndpoint is the endpoint to create association types of a custom object
+	associationsEndpoint = "crm/v3/schemas/%s/associations"
+	// associationEndpoint is the endpoint to delete an association type of a custom object
+	associationEndpoint = "crm/v3/schemas/%s/associations/%s"
+)
+
+// Schemas contains the elements to communicate with the HubSpot CRM Schemas endpoints.
+type Schemas struct {
+	*client.Client
+	Archived bool
+}
+
+// New creates a new instance of the Schemas service with default settings.
+func New(c *client.Client) *Schemas {
+	return &Schemas{
+		c, false,
+	}
+}
+
+// WithArchived sets whether archived schemas are listed instead of active ones, returning a Schemas pointer
+// for chaining.
+func (s *Schemas) WithArchived(archived bool) *Schemas {
+	s.Archived = archived
+	return s
+}
+
+// GetAllSchemas gets the schemas of all custom objects in the portal.
+func (s *Schemas) GetAllSchemas() ([]Schema, error) {
+	url := buildURL(s, schemasEndpoint, true)
+
+	res, err := s.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotSchemas(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Results, nil
+}
+
+// GetSchema gets the schema of a custom object by its fully qualified name or object type ID.
+func (s *Schemas) GetSchema(objectType string) (Schema, error) {
+	url := buildURL(s, fmt.Sprintf(schemaEndpoint, objectType), false)
+
+	res, err := s.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	return unmarshalSchema(res)
+}
+
+// CreateSchema creates a new custom object.
+func (s *Schemas) CreateSchema(schema SchemaInput) (Schema, error) {
+	url := buildURL(s, schemasEndpoint, false)
+
+	payload, err := json.Marshal(schema)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	res, err := s.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	return unmarshalSchema(res)
+}
+
+// UpdateSchema updates the labels and display settings of a custom object.
+func (s *Schemas) UpdateSchema(objectType string, update SchemaUpdate) (Schema, error) {
+	url := buildURL(s, fmt.Sprintf(schemaEndpoint, objectType), false)
+
+	payload, err := json.Marshal(update)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	res, err := s.Call(url, http.MethodPatch, payload)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	return unmarshalSchema(res)
+}
+
+// DeleteSchema deletes the schema of a custom object. A schema can only be deleted when all of its records have
+// been archived.
+func (s *Schemas) DeleteSchema(objectType string) error {
+	url := buildURL(s, fmt.Sprintf(schemaEndpoint, objectType), false)
+
+	_, err := s.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// PurgeSchema permanently deletes the schema of a custom object that was deleted before, so its name can be
+// used again.
+func (s *Schemas) PurgeSchema(objectType string) error {
+	url := fmt.Sprintf("%s&archived=true", buildURL(s, fmt.Sprintf(schemaEndpoint, objectType), false))
+
+	_, err := s.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// CreateProperty adds a property to a custom object.
+func (s *Schemas) CreateProperty(objectType string, property Property) (Property, error) {
+	return properties.New(s.Client, crm.ObjectType(objectType)).CreateProperty(property)
+}
+
+// UpdateProperty updates a property of a custom object. Only the fields set in the update are changed.
+func (s *Schemas) UpdateProperty(objectType string, propertyName string, update PropertyUpdate) (Property, error) {
+	return properties.New(s.Client, crm.ObjectType(objectType)).UpdateProperty(propertyName, update)
+}
+
+// DeleteProperty deletes a property of a custom object.
+func (s *Schemas) DeleteProperty(objectType string, propertyName string) error {
+	return properties.New(s.Client, crm.ObjectType(objectType)).DeleteProperty(propertyName)
+}
+
+// CreateAssociation creates an association type between a custom object and another object type.
+func (s *Schemas) CreateAssociation(objectType string, association AssociationDefinition) (AssociationDefinition, error) {
+	url := fmt.Sprintf("%s?hapikey=%s", fmt.Sprintf(associationsEndpoint, objectType), s.APIKey)
+
+	payload, err := json.Marshal(association)
+	if err != nil {
+		return AssociationDefinition{}, err
+	}
+
+	res, err := s.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return AssociationDefinition{}, err
+	}
+
+	return unmarshalAssociationDefinition(res)
+}
+
+// DeleteAssociation deletes an association type of a custom object.
+func (s *Schemas) DeleteAssociation(objectType string, associationID string) error {
+	url := fmt.Sprintf("%s?hapikey=%s", fmt.Sprintf(associationEndpoint, objectType, associationID), s.APIKey)
+
+	_, err := s.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// Objects returns a crm.Objects service to get, create, update, archive and search the records of a custom object,
+// identified by its fully qualified name.
+func (s *Schemas) Objects(fullyQualifiedName string) *crm.Objects {
+	return crm.New(s.Client, crm.ObjectType(fullyQualifiedName))
+}
+
+// Construct the proper URL to call
+func buildURL(s *Schemas, u string, list bool) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, s.APIKey)
+
+	if list && s.Archived {
+		url = fmt.Sprintf("%s&archived=true", url)
+	}
+
+	return url
+}
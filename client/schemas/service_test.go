@@ -0,0 +1,21 @@
+// Package schemas covers the CRM Schemas API, which is used to manage the definitions of custom objects: their
+// properties and the association types between them and other objects. The records of a custom object are read
+// and written with the crm package.
+package schemas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildURL(t *testing.T) {
+	s := New(client.NewClient().WithAPIKey("demo"))
+	assert.Equal(t, "crm/v3/schemas?hapikey=demo", buildURL(s, schemasEndpoint, true))
+
+	s = s.WithArchived(true)
+	assert.Equal(t, "crm/v3/schemas?hapikey=demo&archived=true", buildURL(s, schemasEndpoint, true))
+	assert.Equal(t, "crm/v3/schemas/car?hapikey=demo", buildURL(s, fmt.Sprintf(schemaEndpoint, "car"), false))
+}
@@ -0,0 +1,176 @@
+// Package search covers the CRM Search API, which finds contacts, companies, deals, tickets and custom objects
+// using filters on their properties. Queries are built with a Query, for example
+//
+//	q := search.NewQuery().
+//		Where("lifecyclestage", search.In, "lead", "customer").
+//		Where("createdate", search.GT, "1556668800000").
+//		Or().
+//		Where("hs_lead_status", search.EQ, "OPEN").
+//		SortBy("createdate", search.Descending).
+//		WithProperties("email", "firstname")
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/retgits/hubspot/client/crm"
+)
+
+// Operator is the comparison used in a filter
+type Operator string
+
+const (
+	// EQ matches when the property is equal to the value
+	EQ Operator = "EQ"
+	// NEQ matches when the property is not equal to the value
+	NEQ Operator = "NEQ"
+	// LT matches when the property is less than the value
+	LT Operator = "LT"
+	// LTE matches when the property is less than or equal to the value
+	LTE Operator = "LTE"
+	// GT matches when the property is greater than the value
+	GT Operator = "GT"
+	// GTE matches when the property is greater than or equal to the value
+	GTE Operator = "GTE"
+	// Between matches when the property is within the two values
+	Between Operator = "BETWEEN"
+	// In matches when the property is one of the values
+	In Operator = "IN"
+	// NotIn matches when the property is none of the values
+	NotIn Operator = "NOT_IN"
+	// HasProperty matches when the property has a value
+	HasProperty Operator = "HAS_PROPERTY"
+	// NotHasProperty matches when the property doesn't have a value
+	NotHasProperty Operator = "NOT_HAS_PROPERTY"
+	// ContainsToken matches when the property contains the word
+	ContainsToken Operator = "CONTAINS_TOKEN"
+	// NotContainsToken matches when the property doesn't contain the word
+	NotContainsToken Operator = "NOT_CONTAINS_TOKEN"
+)
+
+// Direction is the sort order of a property
+type Direction string
+
+const (
+	// Ascending sorts from low to high
+	Ascending Direction = "ASCENDING"
+	// Descending sorts from high to low
+	Descending Direction = "DESCENDING"
+)
+
+// Query builds a search request. Filters added with Where are combined with AND, and Or starts a new group of
+// filters. An object matches the query when it matches all filters of at least one group.
+type Query struct {
+	groups     []crm.FilterGroup
+	sorts      []crm.Sort
+	text       string
+	properties []string
+	limit      int64
+	after      string
+	errs       []string
+}
+
+// NewQuery creates an empty Query.
+func NewQuery() *Query {
+	return &Query{
+		groups: []crm.FilterGroup{{Filters: make([]crm.Filter, 0)}},
+	}
+}
+
+// Where adds a filter to the current group, returning the Query for chaining. Between takes two values, In and
+// NotIn take one or more, HasProperty and NotHasProperty take none and all other operators take one.
+func (q *Query) Where(propertyName string, operator Operator, values ...string) *Query {
+	filter := crm.Filter{
+		PropertyName: propertyName,
+		Operator:     string(operator),
+	}
+
+	switch operator {
+	case HasProperty, NotHasProperty:
+		if len(values) != 0 {
+			q.errs = append(q.errs, fmt.Sprintf("%s on %s takes no values", operator, propertyName))
+		}
+	case Between:
+		if len(values) != 2 {
+			q.errs = append(q.errs, fmt.Sprintf("%s on %s takes two values", operator, propertyName))
+			break
+		}
+		filter.Value = values[0]
+		filter.HighValue = values[1]
+	case In, NotIn:
+		if len(values) == 0 {
+			q.errs = append(q.errs, fmt.Sprintf("%s on %s takes at least one value", operator, propertyName))
+		}
+		filter.Values = values
+	default:
+		if len(values) != 1 {
+			q.errs = append(q.errs, fmt.Sprintf("%s on %s takes one value", operator, propertyName))
+			break
+		}
+		filter.Value = values[0]
+	}
+
+	current := &q.groups[len(q.groups)-1]
+	current.Filters = append(current.Filters, filter)
+	return q
+}
+
+// Or starts a new group of filters, returning the Query for chaining.
+func (q *Query) Or() *Query {
+	q.groups = append(q.groups, crm.FilterGroup{Filters: make([]crm.Filter, 0)})
+	return q
+}
+
+// Text sets a text to search for in the default searchable properties, returning the Query for chaining.
+func (q *Query) Text(text string) *Query {
+	q.text = text
+	return q
+}
+
+// SortBy adds a sort on a property, returning the Query for chaining.
+func (q *Query) SortBy(propertyName string, direction Direction) *Query {
+	q.sorts = append(q.sorts, crm.Sort{PropertyName: propertyName, Direction: string(direction)})
+	return q
+}
+
+// WithProperties sets the properties returned for each object, returning the Query for chaining.
+func (q *Query) WithProperties(props ...string) *Query {
+	q.properties = props
+	return q
+}
+
+// WithLimit sets the number of objects returned per page, returning the Query for chaining.
+func (q *Query) WithLimit(limit int64) *Query {
+	q.limit = limit
+	return q
+}
+
+// WithAfter sets the cursor of the page to get, returning the Query for chaining.
+func (q *Query) WithAfter(after string) *Query {
+	q.after = after
+	return q
+}
+
+// Request returns the search request for the query, or an error when one of the filters is invalid.
+func (q *Query) Request() (crm.SearchRequest, error) {
+	if len(q.errs) > 0 {
+		return crm.SearchRequest{}, fmt.Errorf("invalid search query: %s", strings.Join(q.errs, "; "))
+	}
+
+	groups := make([]crm.FilterGroup, 0)
+	for _, group := range q.groups {
+		if len(group.Filters) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	return crm.SearchRequest{
+		FilterGroups: groups,
+		Sorts:        q.sorts,
+		Query:        q.text,
+		Properties:   q.properties,
+		Limit:        q.limit,
+		After:        q.after,
+	}, nil
+}
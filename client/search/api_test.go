@@ -0,0 +1,50 @@
+// Package search covers the CRM Search API, which finds contacts, companies, deals, tickets and custom objects
+// using filters on their properties. Queries are built with a Query, for example
+//
+//	q := search.NewQuery().
+//		Where("lifecyclestage", search.In, "lead", "customer").
+//		Where("createdate", search.GT, "1556668800000").
+//		Or().
+//		Where("hs_lead_status", search.EQ, "OPEN").
+//		SortBy("createdate", search.Descending).
+//		WithProperties("email", "firstname")
+package search
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	q := NewQuery().
+		Where("lifecyclestage", In, "lead", "customer").
+		Where("amount", Between, "100", "200").
+		Or().
+		Where("email", HasProperty).
+		SortBy("createdate", Descending).
+		WithProperties("email").
+		WithLimit(10)
+
+	request, err := q.Request()
+	assert.NoError(t, err)
+
+	payload, err := json.Marshal(request)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"filterGroups": [
+			{"filters": [
+				{"propertyName": "lifecyclestage", "operator": "IN", "values": ["lead", "customer"]},
+				{"propertyName": "amount", "operator": "BETWEEN", "value": "100", "highValue": "200"}
+			]},
+			{"filters": [{"propertyName": "email", "operator": "HAS_PROPERTY"}]}
+		],
+		"sorts": [{"propertyName": "createdate", "direction": "DESCENDING"}],
+		"properties": ["email"],
+		"limit": 10
+	}`, string(payload))
+
+	_, err = NewQuery().Where("amount", GT).Request()
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+// Package search covers the CRM Search API, which finds contacts, companies, deals, tickets and custom objects
+// using filters on their properties. Queries are built with a Query, for example
+//
+//	q := search.NewQuery().
+//		Where("lifecyclestage", search.In, "lead", "customer").
+//		Where("createdate", search.GT, "1556668800000").
+//		Or().
+//		Where("hs_lead_status", search.EQ, "OPEN").
+//		SortBy("createdate", search.Descending).
+//		WithProperties("email", "firstname")
+package search
+
+import (
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+// Search contains the elements to communicate with the HubSpot CRM Search endpoint for one type of object.
+type Search struct {
+	*client.Client
+	ObjectType crm.ObjectType
+}
+
+// New creates a new instance of the Search service for the given object type. For custom objects the object type
+// is the fully qualified name or the object type ID of the custom object.
+func New(c *client.Client, objectType crm.ObjectType) *Search {
+	return &Search{
+		c, objectType,
+	}
+}
+
+// Page gets a single page of objects matching the query. Use the After of the result with WithAfter on the query to
+// get the next page.
+func (s *Search) Page(q *Query) (crm.SearchResult, error) {
+	request, err := q.Request()
+	if err != nil {
+		return crm.SearchResult{}, err
+	}
+
+	return crm.New(s.Client, s.ObjectType).Search(request)
+}
+
+// All gets all objects matching the query, following the paging cursor until the last page. HubSpot doesn't
+// return more than 10,000 results for a single query.
+func (s *Search) All(q *Query) ([]crm.Object, error) {
+	request, err := q.Request()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := crm.New(s.Client, s.ObjectType)
+
+	temp, err := objects.Search(request)
+	if err != nil {
+		return nil, err
+	}
+
+	results := temp.Results
+
+	for temp.After() != "" {
+		request.After = temp.After()
+
+		temp, err = objects.Search(request)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, temp.Results...)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,109 @@
+// Package subscriptions covers the communication preferences API, which manages the email subscription types of a
+// portal and the subscription status of contacts.
+package subscriptions
+
+import "encoding/json"
+
+const (
+	// StatusSubscribed means the contact is subscribed to the subscription type
+	StatusSubscribed = "SUBSCRIBED"
+	// StatusNotSubscribed means the contact is unsubscribed from, or never subscribed to, the subscription type
+	StatusNotSubscribed = "NOT_SUBSCRIBED"
+)
+
+const (
+	// LegalBasisLegitimateInterestPQL is the legitimate interest in a product qualified lead
+	LegalBasisLegitimateInterestPQL = "LEGITIMATE_INTEREST_PQL"
+	// LegalBasisLegitimateInterestClient is the legitimate interest in an existing customer
+	LegalBasisLegitimateInterestClient = "LEGITIMATE_INTEREST_CLIENT"
+	// LegalBasisLegitimateInterestOther is any other legitimate interest
+	LegalBasisLegitimateInterestOther = "LEGITIMATE_INTEREST_OTHER"
+	// LegalBasisPerformanceOfContract is the performance of a contract with the contact
+	LegalBasisPerformanceOfContract = "PERFORMANCE_OF_CONTRACT"
+	// LegalBasisConsentWithNotice is the freely given consent of the contact
+	LegalBasisConsentWithNotice = "CONSENT_WITH_NOTICE"
+	// LegalBasisProcessAndStore is the consent to process and store the contact's data
+	LegalBasisProcessAndStore = "PROCESS_AND_STORE"
+	// LegalBasisNonGDPR is used for contacts the GDPR doesn't apply to
+	LegalBasisNonGDPR = "NON_GDPR"
+)
+
+// HubSpotDefinitions is the payload returned after listing subscription types
+type HubSpotDefinitions struct {
+	SubscriptionDefinitions []Definition `json:"subscriptionDefinitions"`
+}
+
+// Definition is a subscription type of the portal
+type Definition struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	Purpose             string `json:"purpose"`
+	CommunicationMethod string `json:"communicationMethod"`
+	IsActive            bool   `json:"isActive"`
+	IsDefault           bool   `json:"isDefault"`
+	IsInternal          bool   `json:"isInternal"`
+	CreatedAt           string `json:"createdAt"`
+	UpdatedAt           string `json:"updatedAt"`
+}
+
+// Statuses are the subscription statuses of a single contact
+type Statuses struct {
+	Recipient            string   `json:"recipient"`
+	SubscriptionStatuses []Status `json:"subscriptionStatuses"`
+}
+
+// Status is the status of a contact for a single subscription type
+type Status struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Description           string `json:"description"`
+	Status                string `json:"status"`
+	SourceOfStatus        string `json:"sourceOfStatus"`
+	PreferenceGroupName   string `json:"preferenceGroupName,omitempty"`
+	LegalBasis            string `json:"legalBasis,omitempty"`
+	LegalBasisExplanation string `json:"legalBasisExplanation,omitempty"`
+}
+
+// StatusUpdate is the payload sent to subscribe a contact to, or unsubscribe a contact from, a subscription type.
+// The legal basis fields are required for portals with GDPR features turned on.
+type StatusUpdate struct {
+	EmailAddress          string `json:"emailAddress"`
+	SubscriptionID        string `json:"subscriptionId"`
+	LegalBasis            string `json:"legalBasis,omitempty"`
+	LegalBasisExplanation string `json:"legalBasisExplanation,omitempty"`
+}
+
+// Subscribed returns true if the contact is subscribed to the subscription type with the given ID
+func (s *Statuses) Subscribed(subscriptionID string) bool {
+	for _, status := range s.SubscriptionStatuses {
+		if status.ID == subscriptionID {
+			return status.Status == StatusSubscribed
+		}
+	}
+
+	return false
+}
+
+// Marshal takes a StatusUpdate struct and transforms it into a byte array
+func (r *StatusUpdate) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func unmarshalHubSpotDefinitions(data []byte) (HubSpotDefinitions, error) {
+	var r HubSpotDefinitions
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalStatuses(data []byte) (Statuses, error) {
+	var r Statuses
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalStatus(data []byte) (Status, error) {
+	var r Status
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,34 @@
+// Package subscriptions covers the communication preferences API, which manages the email subscription types of a
+// portal and the subscription status of contacts.
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribed(t *testing.T) {
+	statuses, err := unmarshalStatuses([]byte(`{"recipient":"codey@example.com","subscriptionStatuses":[
+		{"id":"1","name":"Newsletter","status":"SUBSCRIBED","sourceOfStatus":"SUBSCRIPTION_STATUS"},
+		{"id":"2","name":"Product updates","status":"NOT_SUBSCRIBED","sourceOfStatus":"SUBSCRIPTION_STATUS"}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "codey@example.com", statuses.Recipient)
+
+	assert.True(t, statuses.Subscribed("1"))
+	assert.False(t, statuses.Subscribed("2"))
+	assert.False(t, statuses.Subscribed("3"))
+}
+
+func TestStatusUpdate(t *testing.T) {
+	update := StatusUpdate{EmailAddress: "codey@example.com", SubscriptionID: "1"}
+	payload, err := update.Marshal()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"emailAddress":"codey@example.com","subscriptionId":"1"}`, string(payload))
+
+	update.LegalBasis = LegalBasisConsentWithNotice
+	update.LegalBasisExplanation = "Opted in at the conference"
+	payload, err = update.Marshal()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"emailAddress":"codey@example.com","subscriptionId":"1","legalBasis":"CONSENT_WITH_NOTICE","legalBasisExplanation":"Opted in at the conference"}`, string(payload))
+}
@@ -0,0 +1,96 @@
+// Package subscriptions covers the communication preferences API, which manages the email subscription types of a
+// portal and the subscription status of contacts.
+package subscriptions
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	// definitionsEndpoint is the endpoint to list the subscription types of the portal
+	definitionsEndpoint = "communication-preferences/v3/definitions"
+	// statusEndpoint is the endpoint to get the subscription statuses of a contact
+	statusEndpoint = "communication-preferences/v3/status/email/%s"
+	// subscribeEndpoint is the endpoint to subscribe a contact to a subscription type
+	subscribeEndpoint = "communication-preferences/v3/subscribe"
+	// unsubscribeEndpoint is the endpoint to unsubscribe a contact from a subscription type
+	unsubscribeEndpoint = "communication-preferences/v3/unsubscribe"
+)
+
+// Subscriptions contains the elements to communicate with the HubSpot communication preferences endpoints.
+type Subscriptions struct {
+	*client.Client
+}
+
+// New creates a new instance of the Subscriptions service with default settings.
+func New(c *client.Client) *Subscriptions {
+	return &Subscriptions{
+		c,
+	}
+}
+
+// GetAllDefinitions gets all subscription types of the portal.
+func (s *Subscriptions) GetAllDefinitions() ([]Definition, error) {
+	url := buildURL(s, definitionsEndpoint)
+
+	res, err := s.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotDefinitions(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.SubscriptionDefinitions, nil
+}
+
+// GetStatuses gets the subscription statuses of the contact with the given email address.
+func (s *Subscriptions) GetStatuses(email string) (Statuses, error) {
+	url := buildURL(s, fmt.Sprintf(statusEndpoint, url.PathEscape(email)))
+
+	res, err := s.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Statuses{}, err
+	}
+
+	return unmarshalStatuses(res)
+}
+
+// Subscribe subscribes the contact to a subscription type, returning the new status.
+func (s *Subscriptions) Subscribe(update StatusUpdate) (Status, error) {
+	return s.updateStatus(subscribeEndpoint, update)
+}
+
+// Unsubscribe unsubscribes the contact from a subscription type, returning the new status.
+func (s *Subscriptions) Unsubscribe(update StatusUpdate) (Status, error) {
+	return s.updateStatus(unsubscribeEndpoint, update)
+}
+
+func (s *Subscriptions) updateStatus(endpoint string, update StatusUpdate) (Status, error) {
+	url := buildURL(s, endpoint)
+
+	payload, err := update.Marshal()
+	if err != nil {
+		return Status{}, err
+	}
+
+	res, err := s.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return Status{}, err
+	}
+
+	return unmarshalStatus(res)
+}
+
+// Construct the proper URL to call
+func buildURL(s *Subscriptions, u string) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, s.APIKey)
+	return url
+}
@@ -4,21 +4,197 @@ package tickets
 
 import (
 	"encoding/json"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	// SubjectProperty is the name of the property containing the subject of a ticket
+	SubjectProperty = "subject"
+	// ContentProperty is the name of the property containing the description of a ticket
+	ContentProperty = "content"
+	// PipelineProperty is the name of the property containing the pipeline a ticket is in
+	PipelineProperty = "hs_pipeline"
+	// StageProperty is the name of the property containing the pipeline stage of a ticket
+	StageProperty = "hs_pipeline_stage"
+	// PriorityProperty is the name of the property containing the priority of a ticket
+	PriorityProperty = "hs_ticket_priority"
+	// CreateDateProperty is the name of the property containing the date a ticket was created
+	CreateDateProperty = "createdate"
 )
 
+// HubSpotTickets is the payload returned after calling the paged Tickets API
 type HubSpotTickets struct {
 	Objects []Object `json:"objects"`
 	HasMore bool     `json:"hasMore"`
 	Offset  int64    `json:"offset"`
 }
 
+// Object is a single ticket in HubSpot
 type Object struct {
-	ObjectType string                            `json:"objectType"`
-	PortalID   int64                             `json:"portalId"`
-	ObjectID   int64                             `json:"objectId"`
-	Properties map[string]map[string]interface{} `json:"properties"`
-	Version    int64                             `json:"version"`
-	IsDeleted  bool                              `json:"isDeleted"`
+	ObjectType string                          `json:"objectType"`
+	PortalID   int64                           `json:"portalId"`
+	ObjectID   int64                           `json:"objectId"`
+	Properties map[string]client.PropertyValue `json:"properties"`
+	Version    int64                           `json:"version"`
+	IsDeleted  bool                            `json:"isDeleted"`
+}
+
+// Property returns the value of the named property, or an empty string when the ticket doesn't have it.
+func (o *Object) Property(name string) string {
+	return o.Properties[name].Value
+}
+
+// Subject returns the subject of the ticket
+func (o *Object) Subject() string {
+	return o.Property(SubjectProperty)
+}
+
+// Content returns the description of the ticket
+func (o *Object) Content() string {
+	return o.Property(ContentProperty)
+}
+
+// Pipeline returns the ID of the pipeline the ticket is in
+func (o *Object) Pipeline() string {
+	return o.Property(PipelineProperty)
+}
+
+// Stage returns the ID of the pipeline stage the ticket is in
+func (o *Object) Stage() string {
+	return o.Property(StageProperty)
+}
+
+// Priority returns the priority of the ticket (LOW, MEDIUM or HIGH)
+func (o *Object) Priority() string {
+	return o.Property(PriorityProperty)
+}
+
+// CreatedAt returns the date the ticket was created. The zero time is returned when the createdate property
+// wasn't requested or can't be parsed.
+func (o *Object) CreatedAt() time.Time {
+	millis, err := o.Properties[CreateDateProperty].Int64()
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(0, millis*int64(time.Millisecond))
+}
+
+// Property is a struct used to set the value of a ticket property
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Properties is the list of property values sent to HubSpot when creating or updating a ticket
+type Properties []Property
+
+// BatchIDs is a struct used to read or delete tickets in bulk
+type BatchIDs struct {
+	IDs []int64 `json:"ids"`
+}
+
+// BatchUpdate is a struct used to update the properties of a ticket as part of a batch
+type BatchUpdate struct {
+	ObjectID   int64      `json:"objectId"`
+	Properties Properties `json:"properties"`
+}
+
+// ChangeType is the kind of change recorded in the ticket change log
+type ChangeType string
+
+const (
+	// ChangeCreated is used when a ticket was created
+	ChangeCreated ChangeType = "CREATED"
+	// ChangeChanged is used when the properties of a ticket were updated
+	ChangeChanged ChangeType = "CHANGED"
+	// ChangeDeleted is used when a ticket was deleted
+	ChangeDeleted ChangeType = "DELETED"
+)
+
+// ChangeLogEntry describes a single change to a ticket
+type ChangeLogEntry struct {
+	ObjectID    int64      `json:"objectId"`
+	ChangeType  ChangeType `json:"changeType"`
+	Timestamp   int64      `json:"timestamp"`
+	ChangeLogID string     `json:"changeLogId,omitempty"`
+}
+
+// Time returns the moment the change happened
+func (r *ChangeLogEntry) Time() time.Time {
+	return time.Unix(0, r.Timestamp*int64(time.Millisecond))
+}
+
+// Checkpoint is the position in the ticket change log after a given change. It can be stored (for example as
+// JSON) and used to resume reading the change log later on.
+type Checkpoint struct {
+	Timestamp   int64  `json:"timestamp"`
+	ChangeLogID string `json:"changeLogId,omitempty"`
+}
+
+// CheckpointAt returns a Checkpoint to read all changes that happened after t
+func CheckpointAt(t time.Time) Checkpoint {
+	return Checkpoint{
+		Timestamp: t.UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// Checkpoint returns the position in the change log right after this entry
+func (r *ChangeLogEntry) Checkpoint() Checkpoint {
+	return Checkpoint{
+		Timestamp:   r.Timestamp,
+		ChangeLogID: r.ChangeLogID,
+	}
+}
+
+// Marshal takes a Properties slice and transforms it into a byte array
+func (r Properties) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// Marshal takes a BatchIDs struct and transforms it into a byte array
+func (r *BatchIDs) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func newProperties(props map[string]string) Properties {
+	properties := make(Properties, 0)
+
+	for key, val := range props {
+		prop := Property{
+			Name:  key,
+			Value: val,
+		}
+		properties = append(properties, prop)
+	}
+
+	return properties
+}
+
+func unmarshalObject(data []byte) (Object, error) {
+	var r Object
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalObjects(data []byte) ([]Object, error) {
+	var r []Object
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalObjectMap(data []byte) (map[string]Object, error) {
+	var r map[string]Object
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalChangeLog(data []byte) ([]ChangeLogEntry, error) {
+	var r []ChangeLogEntry
+	err := json.Unmarshal(data, &r)
+	return r, err
 }
 
 func unmarshalTickets(data []byte) (HubSpotTickets, error) {
@@ -0,0 +1,67 @@
+// Package tickets is part of HubSpots preview program, and should be considered as a non-stable release that
+// will be subject to bugs and breaking changes while under development.
+package tickets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicketProperties(t *testing.T) {
+	data := []byte(`{"objectType":"TICKET","objectId":176602,"properties":{
+		"subject":{"value":"Printer is on fire","timestamp":1557506532291,"source":"API","sourceId":null,"versions":[{"name":"subject","value":"Printer is on fire","timestamp":1557506532291,"source":"API","sourceVid":[]}]},
+		"hs_pipeline_stage":{"value":"1","timestamp":1557506532291,"source":"API","sourceId":null},
+		"createdate":{"value":"1557506532291","timestamp":1557506532291,"source":"API","sourceId":null}}}`)
+
+	ticket, err := unmarshalObject(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Printer is on fire", ticket.Subject())
+	assert.Equal(t, "1", ticket.Stage())
+	assert.Equal(t, "", ticket.Priority())
+	assert.Len(t, ticket.Properties[SubjectProperty].Versions, 1)
+	assert.Equal(t, time.Unix(1557506532, 291000000), ticket.CreatedAt())
+}
+
+func TestChangeLogIterator(t *testing.T) {
+	pages := [][]ChangeLogEntry{
+		{
+			{ObjectID: 1, ChangeType: ChangeCreated, Timestamp: 100, ChangeLogID: "a"},
+			{ObjectID: 2, ChangeType: ChangeChanged, Timestamp: 200, ChangeLogID: "b"},
+		},
+	}
+	calls := 0
+	it := &ChangeLogIterator{
+		fetch: func(timestamp int64, changeLogID string) ([]ChangeLogEntry, error) {
+			assert.Equal(t, int64(50), timestamp)
+			calls++
+			return pages[0], nil
+		},
+		checkpoint: Checkpoint{Timestamp: 50},
+	}
+
+	changes := make([]ChangeLogEntry, 0)
+	for it.Next() {
+		changes = append(changes, it.Change())
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 1, calls)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, Checkpoint{Timestamp: 200, ChangeLogID: "b"}, it.Checkpoint())
+
+	// Resuming returns the entry at the checkpoint again, which must be skipped
+	resumed := &ChangeLogIterator{
+		fetch: func(timestamp int64, changeLogID string) ([]ChangeLogEntry, error) {
+			assert.Equal(t, "b", changeLogID)
+			return []ChangeLogEntry{
+				{ObjectID: 2, ChangeType: ChangeChanged, Timestamp: 200, ChangeLogID: "b"},
+				{ObjectID: 2, ChangeType: ChangeDeleted, Timestamp: 300, ChangeLogID: "c"},
+			}, nil
+		},
+		checkpoint: it.Checkpoint(),
+	}
+	assert.True(t, resumed.Next())
+	assert.Equal(t, ChangeDeleted, resumed.Change().ChangeType)
+	assert.False(t, resumed.Next())
+}
@@ -3,6 +3,7 @@
 package tickets
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -11,8 +12,24 @@ import (
 
 const (
 	defaultOffSet int64 = 0
+	// changeLogPageSize is the maximum number of entries HubSpot returns from the change log endpoint
+	changeLogPageSize = 1000
 	// allTicketsEndpoint is the endpoint to retrieve all tickets
 	allTicketsEndpoint = "crm-objects/v1/objects/tickets/paged"
+	// ticketsEndpoint is the endpoint to create tickets
+	ticketsEndpoint = "crm-objects/v1/objects/tickets"
+	// ticketEndpoint is the endpoint to get, update and delete a single ticket
+	ticketEndpoint = "crm-objects/v1/objects/tickets/%d"
+	// batchReadEndpoint is the endpoint to get a group of tickets by ID
+	batchReadEndpoint = "crm-objects/v1/objects/tickets/batch-read"
+	// batchCreateEndpoint is the endpoint to create a group of tickets
+	batchCreateEndpoint = "crm-objects/v1/objects/tickets/batch-create"
+	// batchUpdateEndpoint is the endpoint to update a group of tickets
+	batchUpdateEndpoint = "crm-objects/v1/objects/tickets/batch-update"
+	// batchDeleteEndpoint is the endpoint to delete a group of tickets
+	batchDeleteEndpoint = "crm-objects/v1/objects/tickets/batch-delete"
+	// changeLogEndpoint is the endpoint to get the changes to tickets
+	changeLogEndpoint = "crm-objects/v1/change-logs/tickets"
 )
 
 // Tickets contains the elements to communicate with the HubSpot Tickets endpoints.
@@ -48,7 +65,7 @@ func (t *Tickets) WithProperties(props []string) *Tickets {
 // any tickets in the response. If you want to get specific properties, you'll need to use the properties
 // parameter.
 func (t *Tickets) GetAllTickets() ([]Object, error) {
-	url := buildURL(t, allTicketsEndpoint)
+	url := buildURL(t, allTicketsEndpoint, true)
 
 	res, err := t.Call(url, http.MethodGet, nil)
 	if err != nil {
@@ -65,7 +82,7 @@ func (t *Tickets) GetAllTickets() ([]Object, error) {
 
 	if temp.HasMore {
 		for {
-			url := buildURL(t, allTicketsEndpoint)
+			url := buildURL(t, allTicketsEndpoint, true)
 			res, err := t.Call(url, http.MethodGet, nil)
 			if err != nil {
 				return nil, err
@@ -88,12 +105,250 @@ func (t *Tickets) GetAllTickets() ([]Object, error) {
 	return tickets, nil
 }
 
+// GetTicket gets a single ticket by its ID. The properties set with WithProperties are returned on the ticket.
+func (t *Tickets) GetTicket(ticketID int64) (Object, error) {
+	url := buildURL(t, fmt.Sprintf(ticketEndpoint, ticketID), false)
+
+	res, err := t.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Object{}, err
+	}
+
+	return unmarshalObject(res)
+}
+
+// CreateTicket creates a ticket in HubSpot. The map[string]string represents the values for the ticket, where
+// the map key is the name of the property and the map value is the value. A ticket needs at least the
+// hs_pipeline and hs_pipeline_stage properties.
+func (t *Tickets) CreateTicket(props map[string]string) (Object, error) {
+	url := buildURL(t, ticketsEndpoint, false)
+
+	payload, err := newProperties(props).Marshal()
+	if err != nil {
+		return Object{}, err
+	}
+
+	res, err := t.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return Object{}, err
+	}
+
+	return unmarshalObject(res)
+}
+
+// UpdateTicket updates the properties of an existing ticket in HubSpot. The map[string]string represents the new
+// values for the ticket, where the map key is the name of the property and the map value is the new value.
+func (t *Tickets) UpdateTicket(ticketID int64, props map[string]string) (Object, error) {
+	url := buildURL(t, fmt.Sprintf(ticketEndpoint, ticketID), false)
+
+	payload, err := newProperties(props).Marshal()
+	if err != nil {
+		return Object{}, err
+	}
+
+	res, err := t.Call(url, http.MethodPut, payload)
+	if err != nil {
+		return Object{}, err
+	}
+
+	return unmarshalObject(res)
+}
+
+// DeleteTicket deletes a ticket from HubSpot.
+func (t *Tickets) DeleteTicket(ticketID int64) error {
+	url := buildURL(t, fmt.Sprintf(ticketEndpoint, ticketID), false)
+
+	_, err := t.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// BatchGetTickets gets a group of tickets by their IDs. The returned map is keyed by the ticket ID.
+func (t *Tickets) BatchGetTickets(ticketIDs []int64) (map[string]Object, error) {
+	url := buildURL(t, batchReadEndpoint, false)
+
+	ids := BatchIDs{
+		IDs: ticketIDs,
+	}
+
+	payload, err := ids.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalObjectMap(res)
+}
+
+// BatchCreateTickets creates a group of tickets. Each map in props represents the values of one ticket.
+func (t *Tickets) BatchCreateTickets(props []map[string]string) ([]Object, error) {
+	url := buildURL(t, batchCreateEndpoint, false)
+
+	tickets := make([]Properties, 0)
+	for idx := range props {
+		tickets = append(tickets, newProperties(props[idx]))
+	}
+
+	payload, err := json.Marshal(tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalObjects(res)
+}
+
+// BatchUpdateTickets updates a group of tickets. The map is keyed by ticket ID, and the values are the new
+// property values of that ticket.
+func (t *Tickets) BatchUpdateTickets(props map[int64]map[string]string) error {
+	url := buildURL(t, batchUpdateEndpoint, false)
+
+	updates := make([]BatchUpdate, 0)
+	for ticketID, ticketProps := range props {
+		update := BatchUpdate{
+			ObjectID:   ticketID,
+			Properties: newProperties(ticketProps),
+		}
+		updates = append(updates, update)
+	}
+
+	payload, err := json.Marshal(updates)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.Call(url, http.MethodPost, payload)
+	return err
+}
+
+// BatchDeleteTickets deletes a group of tickets by their IDs.
+func (t *Tickets) BatchDeleteTickets(ticketIDs []int64) error {
+	url := buildURL(t, batchDeleteEndpoint, false)
+
+	ids := BatchIDs{
+		IDs: ticketIDs,
+	}
+
+	payload, err := ids.Marshal()
+	if err != nil {
+		return err
+	}
+
+	_, err = t.Call(url, http.MethodPost, payload)
+	return err
+}
+
+// GetChangeLog gets the changes (creations, updates and deletions) to tickets that happened after the given timestamp,
+// in milliseconds since epoch. HubSpot returns at most 1000 changes per call; pass the timestamp and changeLogID of the
+// last returned entry to get the next changes. The changeLogID can be left empty on the first call.
+func (t *Tickets) GetChangeLog(timestamp int64, changeLogID string) ([]ChangeLogEntry, error) {
+	url := buildURL(t, changeLogEndpoint, false)
+	url = fmt.Sprintf("%s&timestamp=%d", url, timestamp)
+
+	if changeLogID != "" {
+		url = fmt.Sprintf("%s&changeLogId=%s", url, changeLogID)
+	}
+
+	res, err := t.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalChangeLog(res)
+}
+
+// ChangeLog returns a ChangeLogIterator that reads all changes to tickets after the given checkpoint. Use
+// CheckpointAt to start from a point in time, or a Checkpoint stored by a previous run to resume from there.
+func (t *Tickets) ChangeLog(from Checkpoint) *ChangeLogIterator {
+	return &ChangeLogIterator{
+		fetch:      t.GetChangeLog,
+		checkpoint: from,
+	}
+}
+
+// ChangeLogIterator walks through the ticket change log, fetching pages from HubSpot as needed. A typical
+// loop looks like
+//
+//	it := svc.ChangeLog(checkpoint)
+//	for it.Next() {
+//		change := it.Change()
+//		...
+//	}
+//	if it.Err() != nil {
+//		...
+//	}
+//	checkpoint = it.Checkpoint()
+type ChangeLogIterator struct {
+	fetch      func(timestamp int64, changeLogID string) ([]ChangeLogEntry, error)
+	checkpoint Checkpoint
+	page       []ChangeLogEntry
+	current    ChangeLogEntry
+	done       bool
+	err        error
+}
+
+// Next advances the iterator to the next change. It returns false when there are no more changes or when
+// an error occurred, which is available from Err.
+func (i *ChangeLogIterator) Next() bool {
+	for len(i.page) == 0 {
+		if i.done || i.err != nil {
+			return false
+		}
+
+		page, err := i.fetch(i.checkpoint.Timestamp, i.checkpoint.ChangeLogID)
+		if err != nil {
+			i.err = err
+			return false
+		}
+
+		if len(page) < changeLogPageSize {
+			i.done = true
+		}
+
+		// The entry the checkpoint points at can be returned again, so skip it
+		for len(page) > 0 && page[0].Checkpoint() == i.checkpoint {
+			page = page[1:]
+		}
+
+		i.page = page
+	}
+
+	i.current = i.page[0]
+	i.page = i.page[1:]
+	i.checkpoint = i.current.Checkpoint()
+
+	return true
+}
+
+// Change returns the change the iterator is at
+func (i *ChangeLogIterator) Change() ChangeLogEntry {
+	return i.current
+}
+
+// Err returns the error that stopped the iterator, if any
+func (i *ChangeLogIterator) Err() error {
+	return i.err
+}
+
+// Checkpoint returns the position after the last change returned by Next. Persist it to resume reading
+// the change log from there.
+func (i *ChangeLogIterator) Checkpoint() Checkpoint {
+	return i.checkpoint
+}
+
 // Construct the proper URL to call
-func buildURL(t *Tickets, u string) string {
+func buildURL(t *Tickets, u string, paged bool) string {
 	url := ""
 	url = fmt.Sprintf("%s?hapikey=%s", u, t.APIKey)
 
-	if t.OffSet > 0 {
+	if paged && t.OffSet > 0 {
 		url = fmt.Sprintf("%s&offset=%d", url, t.OffSet)
 	}
 
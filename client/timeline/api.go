@@ -0,0 +1,197 @@
+// Package timeline covers the Timeline Events API, which lets an app add custom events to the timeline of contacts,
+// companies, deals and tickets. Every event is based on an event template of the app, which defines how the event
+// is shown and which tokens (data fields) it has.
+package timeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/crm"
+)
+
+// The data types a token can have
+const (
+	TokenTypeString      = "string"
+	TokenTypeNumber      = "number"
+	TokenTypeDate        = "date"
+	TokenTypeEnumeration = "enumeration"
+)
+
+// HubSpotTemplates is the payload returned after listing event templates
+type HubSpotTemplates struct {
+	Results []Template `json:"results"`
+}
+
+// Template is an event template of an app
+type Template struct {
+	ID             string         `json:"id,omitempty"`
+	Name           string         `json:"name"`
+	ObjectType     crm.ObjectType `json:"objectType"`
+	HeaderTemplate string         `json:"headerTemplate,omitempty"`
+	DetailTemplate string         `json:"detailTemplate,omitempty"`
+	Tokens         []Token        `json:"tokens"`
+	CreatedAt      *time.Time     `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time     `json:"updatedAt,omitempty"`
+}
+
+// Token is a data field of an event template. When ObjectPropertyName is set, the value of the token is also
+// written to that property of the object the event is created for.
+type Token struct {
+	Name               string        `json:"name"`
+	Label              string        `json:"label"`
+	Type               string        `json:"type"`
+	Options            []TokenOption `json:"options,omitempty"`
+	ObjectPropertyName string        `json:"objectPropertyName,omitempty"`
+	CreatedAt          *time.Time    `json:"createdAt,omitempty"`
+	UpdatedAt          *time.Time    `json:"updatedAt,omitempty"`
+}
+
+// TokenOption is a possible value of an enumeration token
+type TokenOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// Event is a single timeline event. Contacts can be identified by ObjectID, Email or UTK, companies by ObjectID
+// or Domain, and deals and tickets by ObjectID.
+type Event struct {
+	ID              string            `json:"id,omitempty"`
+	EventTemplateID string            `json:"eventTemplateId"`
+	ObjectID        string            `json:"objectId,omitempty"`
+	Email           string            `json:"email,omitempty"`
+	UTK             string            `json:"utk,omitempty"`
+	Domain          string            `json:"domain,omitempty"`
+	Timestamp       *time.Time        `json:"timestamp,omitempty"`
+	Tokens          map[string]string `json:"tokens"`
+	ExtraData       interface{}       `json:"extraData,omitempty"`
+	TimelineIFrame  *IFrame           `json:"timelineIFrame,omitempty"`
+}
+
+// IFrame is a link on the event that opens a page in a modal window
+type IFrame struct {
+	LinkLabel   string `json:"linkLabel"`
+	HeaderLabel string `json:"headerLabel"`
+	URL         string `json:"url"`
+	Width       int64  `json:"width"`
+	Height      int64  `json:"height"`
+}
+
+// BatchEvents is the payload sent to create events in bulk
+type BatchEvents struct {
+	Inputs []Event `json:"inputs"`
+}
+
+// BatchResult is the payload returned after creating events in bulk
+type BatchResult struct {
+	Status  string  `json:"status"`
+	Results []Event `json:"results"`
+}
+
+// Validate checks that the event can be created from the template: the event must identify an object of the
+// template's object type, and every token must exist on the template and have a value of the right type.
+func (t *Template) Validate(event Event) error {
+	problems := make([]string, 0)
+
+	if event.EventTemplateID != "" && t.ID != "" && event.EventTemplateID != t.ID {
+		problems = append(problems, fmt.Sprintf("event is for template %s, not %s", event.EventTemplateID, t.ID))
+	}
+
+	switch t.ObjectType {
+	case crm.Contacts:
+		if event.ObjectID == "" && event.Email == "" && event.UTK == "" {
+			problems = append(problems, "contact events need an objectId, email or utk")
+		}
+	case crm.Companies:
+		if event.ObjectID == "" && event.Domain == "" {
+			problems = append(problems, "company events need an objectId or domain")
+		}
+	default:
+		if event.ObjectID == "" {
+			problems = append(problems, fmt.Sprintf("%s events need an objectId", t.ObjectType))
+		}
+	}
+
+	tokens := make(map[string]Token)
+	for _, token := range t.Tokens {
+		tokens[token.Name] = token
+	}
+
+	for name, value := range event.Tokens {
+		token, ok := tokens[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown token %s", name))
+			continue
+		}
+
+		if reason := validateToken(token, value); reason != "" {
+			problems = append(problems, fmt.Sprintf("token %s with value %q: %s", name, value, reason))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid event for template %s: %s", t.Name, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// validateToken returns why the value doesn't fit the token, or an empty string when it does.
+func validateToken(token Token, value string) string {
+	switch token.Type {
+	case TokenTypeNumber:
+		if !client.IsDecimal(value) {
+			return "not a number"
+		}
+	case TokenTypeDate:
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return ""
+		}
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return "not a date (milliseconds since epoch or RFC 3339)"
+		}
+	case TokenTypeEnumeration:
+		for _, o := range token.Options {
+			if o.Value == value {
+				return ""
+			}
+		}
+		return "not one of the options"
+	}
+
+	return ""
+}
+
+func unmarshalHubSpotTemplates(data []byte) (HubSpotTemplates, error) {
+	var r HubSpotTemplates
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalTemplate(data []byte) (Template, error) {
+	var r Template
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalToken(data []byte) (Token, error) {
+	var r Token
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalEvent(data []byte) (Event, error) {
+	var r Event
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalBatchResult(data []byte) (BatchResult, error) {
+	var r BatchResult
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
@@ -0,0 +1,52 @@
+// Package timeline covers the Timeline Events API, which lets an app add custom events to the timeline of contacts,
+// companies, deals and tickets. Every event is based on an event template of the app, which defines how the event
+// is shown and which tokens (data fields) it has.
+package timeline
+
+import (
+	"testing"
+
+	"github.com/retgits/hubspot/client/crm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateValidate(t *testing.T) {
+	template := Template{
+		ID:         "1001",
+		Name:       "Feature used",
+		ObjectType: crm.Contacts,
+		Tokens: []Token{
+			{Name: "feature", Type: TokenTypeEnumeration, Options: []TokenOption{{Value: "export"}, {Value: "import"}}},
+			{Name: "count", Type: TokenTypeNumber},
+			{Name: "usedAt", Type: TokenTypeDate},
+		},
+	}
+
+	event := Event{
+		EventTemplateID: "1001",
+		Email:           "codey@example.com",
+		Tokens:          map[string]string{"feature": "export", "count": "3", "usedAt": "1557964800000"},
+	}
+	assert.NoError(t, template.Validate(event))
+
+	event = Event{
+		EventTemplateID: "1001",
+		Tokens:          map[string]string{"feature": "delete", "count": "three", "colour": "red"},
+	}
+	err := template.Validate(event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "need an objectId, email or utk")
+	assert.Contains(t, err.Error(), "unknown token colour")
+	assert.Contains(t, err.Error(), "token count")
+	assert.Contains(t, err.Error(), "token feature")
+}
+
+func TestValidateToken(t *testing.T) {
+	number := Token{Name: "count", Type: TokenTypeNumber}
+	for _, value := range []string{"3", "-3", "19.99", ".5"} {
+		assert.Empty(t, validateToken(number, value), value)
+	}
+	for _, value := range []string{"NaN", "Inf", "1e3", "0x1p-2", "three", ""} {
+		assert.NotEmpty(t, validateToken(number, value), value)
+	}
+}
@@ -0,0 +1,275 @@
+// Package timeline covers the Timeline Events API, which lets an app add custom events to the timeline of contacts,
+// companies, deals and tickets. Every event is based on an event template of the app, which defines how the event
+// is shown and which tokens (data fields) it has.
+package timeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	// templatesEndpoint is the endpoint to list and create event templates
+	templatesEndpoint = "crm/v3/timeline/%d/event-templates"
+	// templateEndpoint is the endpoint to get, update and delete a single event template
+	templateEndpoint = "crm/v3/timeline/%d/event-templates/%s"
+	// tokensEndpoint is the endpoint to create tokens on an event template
+	tokensEndpoint = "crm/v3/timeline/%d/event-templates/%s/tokens"
+	// tokenEndpoint is the endpoint to update and delete a single token
+	tokenEndpoint = "crm/v3/timeline/%d/event-templates/%s/tokens/%s"
+	// eventsEndpoint is the endpoint to create an event
+	eventsEndpoint = "crm/v3/timeline/events"
+	// batchEventsEndpoint is the endpoint to create events in bulk
+	batchEventsEndpoint = "crm/v3/timeline/events/batch/create"
+	// eventEndpoint is the endpoint to get a single event
+	eventEndpoint = "crm/v3/timeline/events/%s/%s"
+)
+
+// Timeline contains the elements to communicate with the HubSpot Timeline endpoints for one app. Managing event
+// templates and tokens uses the developer API key of the client, see client.WithDeveloperKey.
+type Timeline struct {
+	*client.Client
+	AppID int64
+	// Validate makes CreateEvent and CreateEvents check events against their template before sending them
+	Validate  bool
+	mu        sync.Mutex
+	templates map[string]Template
+}
+
+// New creates a new instance of the Timeline service for the given app with default settings.
+func New(c *client.Client, appID int64) *Timeline {
+	return &Timeline{
+		Client:    c,
+		AppID:     appID,
+		templates: make(map[string]Template),
+	}
+}
+
+// WithValidation sets whether events are checked against their template before they are created, returning a
+// Timeline pointer for chaining. Templates are fetched once and cached.
+func (t *Timeline) WithValidation(validate bool) *Timeline {
+	t.Validate = validate
+	return t
+}
+
+// GetAllTemplates gets all event templates of the app.
+func (t *Timeline) GetAllTemplates() ([]Template, error) {
+	url := buildDeveloperURL(t, fmt.Sprintf(templatesEndpoint, t.AppID))
+
+	res, err := t.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotTemplates(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Results, nil
+}
+
+// GetTemplate gets a single event template by its ID.
+func (t *Timeline) GetTemplate(templateID string) (Template, error) {
+	url := buildDeveloperURL(t, fmt.Sprintf(templateEndpoint, t.AppID, templateID))
+
+	res, err := t.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Template{}, err
+	}
+
+	return unmarshalTemplate(res)
+}
+
+// CreateTemplate creates an event template for the app.
+func (t *Timeline) CreateTemplate(template Template) (Template, error) {
+	url := buildDeveloperURL(t, fmt.Sprintf(templatesEndpoint, t.AppID))
+
+	return t.sendTemplate(url, http.MethodPost, template)
+}
+
+// UpdateTemplate updates the event template with the same ID.
+func (t *Timeline) UpdateTemplate(template Template) (Template, error) {
+	url := buildDeveloperURL(t, fmt.Sprintf(templateEndpoint, t.AppID, template.ID))
+
+	t.forget(template.ID)
+	return t.sendTemplate(url, http.MethodPut, template)
+}
+
+// DeleteTemplate deletes an event template. Events created from the template are deleted as well.
+func (t *Timeline) DeleteTemplate(templateID string) error {
+	url := buildDeveloperURL(t, fmt.Sprintf(templateEndpoint, t.AppID, templateID))
+
+	t.forget(templateID)
+	_, err := t.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// CreateToken adds a token to an event template.
+func (t *Timeline) CreateToken(templateID string, token Token) (Token, error) {
+	url := buildDeveloperURL(t, fmt.Sprintf(tokensEndpoint, t.AppID, templateID))
+
+	t.forget(templateID)
+	return t.sendToken(url, http.MethodPost, token)
+}
+
+// UpdateToken updates the token with the same name on an event template. The name and type of a token can't be changed.
+func (t *Timeline) UpdateToken(templateID string, token Token) (Token, error) {
+	url := buildDeveloperURL(t, fmt.Sprintf(tokenEndpoint, t.AppID, templateID, token.Name))
+
+	t.forget(templateID)
+	return t.sendToken(url, http.MethodPut, token)
+}
+
+// DeleteToken removes a token from an event template.
+func (t *Timeline) DeleteToken(templateID string, tokenName string) error {
+	url := buildDeveloperURL(t, fmt.Sprintf(tokenEndpoint, t.AppID, templateID, tokenName))
+
+	t.forget(templateID)
+	_, err := t.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// CreateEvent creates an event on the timeline of an object.
+func (t *Timeline) CreateEvent(event Event) (Event, error) {
+	if err := t.validate(event); err != nil {
+		return Event{}, err
+	}
+
+	url := buildURL(t, eventsEndpoint)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return Event{}, err
+	}
+
+	res, err := t.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return unmarshalEvent(res)
+}
+
+// CreateEvents creates multiple events in a single call. When validation is enabled, no events are created if
+// any of them is invalid.
+func (t *Timeline) CreateEvents(events []Event) ([]Event, error) {
+	for _, event := range events {
+		if err := t.validate(event); err != nil {
+			return nil, err
+		}
+	}
+
+	url := buildURL(t, batchEventsEndpoint)
+
+	payload, err := json.Marshal(BatchEvents{Inputs: events})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalBatchResult(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Results, nil
+}
+
+// GetEvent gets a single event by its template ID and event ID.
+func (t *Timeline) GetEvent(templateID string, eventID string) (Event, error) {
+	url := buildURL(t, fmt.Sprintf(eventEndpoint, templateID, eventID))
+
+	res, err := t.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return unmarshalEvent(res)
+}
+
+// validate checks the event against its (cached) template when validation is enabled.
+func (t *Timeline) validate(event Event) error {
+	if !t.Validate {
+		return nil
+	}
+
+	t.mu.Lock()
+	template, ok := t.templates[event.EventTemplateID]
+	t.mu.Unlock()
+
+	if !ok {
+		var err error
+		template, err = t.GetTemplate(event.EventTemplateID)
+		if err != nil {
+			return err
+		}
+
+		t.mu.Lock()
+		t.templates[event.EventTemplateID] = template
+		t.mu.Unlock()
+	}
+
+	return template.Validate(event)
+}
+
+// forget removes a template from the cache after it has been changed.
+func (t *Timeline) forget(templateID string) {
+	t.mu.Lock()
+	delete(t.templates, templateID)
+	t.mu.Unlock()
+}
+
+// sendTemplate marshals the template, sends it and unmarshals the template that HubSpot returns.
+func (t *Timeline) sendTemplate(url string, httpMethod string, template Template) (Template, error) {
+	payload, err := json.Marshal(template)
+	if err != nil {
+		return Template{}, err
+	}
+
+	res, err := t.Call(url, httpMethod, payload)
+	if err != nil {
+		return Template{}, err
+	}
+
+	return unmarshalTemplate(res)
+}
+
+// sendToken marshals the token, sends it and unmarshals the token that HubSpot returns.
+func (t *Timeline) sendToken(url string, httpMethod string, token Token) (Token, error) {
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return Token{}, err
+	}
+
+	res, err := t.Call(url, httpMethod, payload)
+	if err != nil {
+		return Token{}, err
+	}
+
+	return unmarshalToken(res)
+}
+
+// Construct the proper URL to call
+func buildURL(t *Timeline, u string) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, t.APIKey)
+
+	return url
+}
+
+// Construct the proper URL to call for the endpoints that need the developer API key
+func buildDeveloperURL(t *Timeline, u string) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, t.DeveloperAPIKey())
+
+	return url
+}
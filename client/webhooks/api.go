@@ -0,0 +1,252 @@
+// Package webhooks covers the Webhooks API. It contains an http.Handler that receives the webhook requests HubSpot
+// sends when objects in a portal change, verifies their signature and hands every event to a callback, and a
+// service to manage the webhook settings and subscriptions of an app.
+package webhooks
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// The subscription types of webhook events
+const (
+	ContactCreation            = "contact.creation"
+	ContactDeletion            = "contact.deletion"
+	ContactPropertyChange      = "contact.propertyChange"
+	ContactPrivacyDeletion     = "contact.privacyDeletion"
+	ContactMerge               = "contact.merge"
+	ContactAssociationChange   = "contact.associationChange"
+	CompanyCreation            = "company.creation"
+	CompanyDeletion            = "company.deletion"
+	CompanyPropertyChange      = "company.propertyChange"
+	CompanyMerge               = "company.merge"
+	CompanyAssociationChange   = "company.associationChange"
+	DealCreation               = "deal.creation"
+	DealDeletion               = "deal.deletion"
+	DealPropertyChange         = "deal.propertyChange"
+	DealMerge                  = "deal.merge"
+	DealAssociationChange      = "deal.associationChange"
+	TicketCreation             = "ticket.creation"
+	TicketDeletion             = "ticket.deletion"
+	TicketPropertyChange       = "ticket.propertyChange"
+	TicketMerge                = "ticket.merge"
+	TicketAssociationChange    = "ticket.associationChange"
+	ProductCreation            = "product.creation"
+	ProductDeletion            = "product.deletion"
+	ProductPropertyChange      = "product.propertyChange"
+	LineItemCreation           = "line_item.creation"
+	LineItemDeletion           = "line_item.deletion"
+	LineItemPropertyChange     = "line_item.propertyChange"
+	ConversationCreation       = "conversation.creation"
+	ConversationDeletion       = "conversation.deletion"
+	ConversationPropertyChange = "conversation.propertyChange"
+	ConversationNewMessage     = "conversation.newMessage"
+)
+
+// Event is implemented by the type-specific webhook events in this package. The struct is picked by the action
+// of the subscription type, so a contact.propertyChange and a deal.propertyChange event are both a
+// *PropertyChangeEvent.
+type Event interface {
+	// EventType returns the subscription type of the event, like contact.creation.
+	EventType() string
+	// Details returns the fields all webhook events share.
+	Details() Common
+}
+
+// Common contains the fields all webhook events share
+type Common struct {
+	EventID          int64  `json:"eventId"`
+	SubscriptionID   int64  `json:"subscriptionId"`
+	PortalID         int64  `json:"portalId"`
+	AppID            int64  `json:"appId"`
+	OccurredAt       int64  `json:"occurredAt"`
+	SubscriptionType string `json:"subscriptionType"`
+	AttemptNumber    int64  `json:"attemptNumber"`
+	ObjectID         int64  `json:"objectId"`
+	ChangeSource     string `json:"changeSource"`
+	ChangeFlag       string `json:"changeFlag,omitempty"`
+	SourceID         string `json:"sourceId,omitempty"`
+}
+
+// EventType returns the subscription type of the event
+func (c Common) EventType() string { return c.SubscriptionType }
+
+// Details returns the fields all webhook events share
+func (c Common) Details() Common { return c }
+
+// ObjectType returns the type of object the event is about, like contact or deal
+func (c Common) ObjectType() string {
+	return strings.SplitN(c.SubscriptionType, ".", 2)[0]
+}
+
+// Action returns what happened to the object, like creation or propertyChange
+func (c Common) Action() string {
+	parts := strings.SplitN(c.SubscriptionType, ".", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// Time returns the moment the event occurred
+func (c Common) Time() time.Time {
+	return time.Unix(0, c.OccurredAt*int64(time.Millisecond))
+}
+
+// CreationEvent is a creation event of any object type
+type CreationEvent struct {
+	Common
+}
+
+// DeletionEvent is a deletion or privacy deletion (GDPR) event of any object type
+type DeletionEvent struct {
+	Common
+}
+
+// PropertyChangeEvent is a property change event of any object type
+type PropertyChangeEvent struct {
+	Common
+	PropertyName  string `json:"propertyName"`
+	PropertyValue string `json:"propertyValue"`
+}
+
+// MergeEvent is a merge event of any object type. ObjectID is the ID of the record that remains after the merge.
+type MergeEvent struct {
+	Common
+	MergedObjectIDs []int64 `json:"mergedObjectIds"`
+	PrimaryObjectID int64   `json:"primaryObjectId"`
+	NewObjectID     int64   `json:"newObjectId,omitempty"`
+}
+
+// AssociationChangeEvent is an association change event of any object type
+type AssociationChangeEvent struct {
+	Common
+	AssociationType      string `json:"associationType"`
+	FromObjectID         int64  `json:"fromObjectId"`
+	ToObjectID           int64  `json:"toObjectId"`
+	AssociationRemoved   bool   `json:"associationRemoved"`
+	IsPrimaryAssociation bool   `json:"isPrimaryAssociation,omitempty"`
+}
+
+// NewMessageEvent is a conversation.newMessage event
+type NewMessageEvent struct {
+	Common
+	MessageID   string `json:"messageId"`
+	MessageType string `json:"messageType"`
+}
+
+// RawEvent keeps the webhook events that don't have a dedicated struct in this package
+type RawEvent struct {
+	Common
+	Raw json.RawMessage
+}
+
+// MarshalJSON writes the event back as it was received
+func (e *RawEvent) MarshalJSON() ([]byte, error) {
+	if len(e.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return e.Raw, nil
+}
+
+// Settings are the webhook settings of an app
+type Settings struct {
+	TargetURL  string     `json:"targetUrl"`
+	Throttling Throttling `json:"throttling"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Throttling limits the number of webhook requests HubSpot sends at the same time
+type Throttling struct {
+	Period                string `json:"period"`
+	MaxConcurrentRequests int64  `json:"maxConcurrentRequests"`
+}
+
+// HubSpotSubscriptions is the payload returned after listing subscriptions
+type HubSpotSubscriptions struct {
+	Results []Subscription `json:"results"`
+}
+
+// Subscription makes HubSpot send events of a subscription type to the app. PropertyName is required for
+// property change subscriptions.
+type Subscription struct {
+	ID           string     `json:"id,omitempty"`
+	EventType    string     `json:"eventType"`
+	PropertyName string     `json:"propertyName,omitempty"`
+	Active       bool       `json:"active"`
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time `json:"updatedAt,omitempty"`
+}
+
+// SubscriptionUpdate is the payload sent to activate or pause a subscription
+type SubscriptionUpdate struct {
+	Active bool `json:"active"`
+}
+
+func unmarshalSettings(data []byte) (Settings, error) {
+	var r Settings
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalHubSpotSubscriptions(data []byte) (HubSpotSubscriptions, error) {
+	var r HubSpotSubscriptions
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalSubscription(data []byte) (Subscription, error) {
+	var r Subscription
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalEvents(data []byte) ([]Event, error) {
+	var temp []json.RawMessage
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(temp))
+
+	for _, raw := range temp {
+		event, err := unmarshalEvent(raw)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func unmarshalEvent(data []byte) (Event, error) {
+	var common Common
+	if err := json.Unmarshal(data, &common); err != nil {
+		return nil, err
+	}
+
+	var e Event
+
+	switch common.Action() {
+	case "creation":
+		e = &CreationEvent{}
+	case "deletion", "privacyDeletion":
+		e = &DeletionEvent{}
+	case "propertyChange":
+		e = &PropertyChangeEvent{}
+	case "merge":
+		e = &MergeEvent{}
+	case "associationChange":
+		e = &AssociationChangeEvent{}
+	case "newMessage":
+		e = &NewMessageEvent{}
+	default:
+		return &RawEvent{Common: common, Raw: data}, nil
+	}
+
+	err := json.Unmarshal(data, e)
+	return e, err
+}
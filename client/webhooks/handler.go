@@ -0,0 +1,228 @@
+// Package webhooks covers the Webhooks API. It contains an http.Handler that receives the webhook requests HubSpot
+// sends when objects in a portal change, verifies their signature and hands every event to a callback, and a
+// service to manage the webhook settings and subscriptions of an app.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTolerance = 5 * time.Minute
+	// defaultMaxBodySize is the largest request body the handler reads. HubSpot sends at most 100 events per
+	// request, which is well below this limit.
+	defaultMaxBodySize int64 = 1 << 20
+	// signatureHeader contains the v1 or v2 signature
+	signatureHeader = "X-HubSpot-Signature"
+	// signatureVersionHeader contains the version of the signature in signatureHeader
+	signatureVersionHeader = "X-HubSpot-Signature-Version"
+	// signatureV3Header contains the v3 signature
+	signatureV3Header = "X-HubSpot-Signature-v3"
+	// timestampHeader contains the time the request was sent, in milliseconds since epoch
+	timestampHeader = "X-HubSpot-Request-Timestamp"
+)
+
+// Callback is called for every received event. Use a type switch to get the fields of the subscription type, for
+// example *PropertyChangeEvent for property changes. Returning an error makes the handler answer with a 500 status
+// once all events of the request have been handled, which makes HubSpot send the whole request again later. Events
+// are therefore delivered at least once: callbacks must be idempotent, for example by skipping events with an
+// EventID they have already seen.
+type Callback func(event Event) error
+
+// Handler is an http.Handler for HubSpot webhook requests. Use it as the target URL of the app's webhook settings:
+//
+//	handler := webhooks.NewHandler(clientSecret).
+//		On(webhooks.ContactCreation, func(e webhooks.Event) error { ... }).
+//		On(webhooks.DealPropertyChange, func(e webhooks.Event) error { ... })
+//	http.Handle("/hubspot/webhooks", handler)
+type Handler struct {
+	// ClientSecret is the client secret of the app, used to verify signatures
+	ClientSecret string
+	// Tolerance is how far the timestamp of a v3 signed request may be from the current time before it is rejected
+	Tolerance time.Duration
+	// MaxBodySize is the largest request body, in bytes, the handler reads
+	MaxBodySize int64
+	// URL is the public URL of the handler as configured in HubSpot. It is needed to verify v2 and v3 signatures
+	// when the handler runs behind a proxy that changes the host or scheme.
+	URL       string
+	callbacks map[string]Callback
+	fallback  Callback
+	now       func() time.Time
+}
+
+// NewHandler creates a new Handler that verifies requests with the app's client secret.
+func NewHandler(clientSecret string) *Handler {
+	return &Handler{
+		ClientSecret: clientSecret,
+		Tolerance:    defaultTolerance,
+		MaxBodySize:  defaultMaxBodySize,
+		callbacks:    make(map[string]Callback),
+		now:          time.Now,
+	}
+}
+
+// WithTolerance sets how far the timestamp of a v3 signed request may be from the current time, returning a
+// Handler pointer for chaining.
+func (h *Handler) WithTolerance(tolerance time.Duration) *Handler {
+	h.Tolerance = tolerance
+	return h
+}
+
+// WithMaxBodySize sets the largest request body the handler reads, returning a Handler pointer for chaining.
+func (h *Handler) WithMaxBodySize(size int64) *Handler {
+	h.MaxBodySize = size
+	return h
+}
+
+// WithURL sets the public URL of the handler, returning a Handler pointer for chaining.
+func (h *Handler) WithURL(url string) *Handler {
+	h.URL = url
+	return h
+}
+
+// On sets the callback for events of a subscription type, returning a Handler pointer for chaining.
+func (h *Handler) On(subscriptionType string, callback Callback) *Handler {
+	h.callbacks[subscriptionType] = callback
+	return h
+}
+
+// OnAny sets the callback for events of subscription types that don't have their own callback, returning a
+// Handler pointer for chaining.
+func (h *Handler) OnAny(callback Callback) *Handler {
+	h.fallback = callback
+	return h
+}
+
+// ServeHTTP verifies the signature of a webhook request and calls the callbacks for its events.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, h.MaxBodySize))
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Verify(r, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	events, err := unmarshalEvents(body)
+	if err != nil {
+		http.Error(w, "invalid events", http.StatusBadRequest)
+		return
+	}
+
+	// All events are handled, even when a callback fails, so one bad event doesn't hold up the others
+	failures := make([]string, 0)
+
+	for _, event := range events {
+		callback, ok := h.callbacks[event.EventType()]
+		if !ok {
+			callback = h.fallback
+		}
+
+		if callback == nil {
+			continue
+		}
+
+		if err := callback(event); err != nil {
+			failures = append(failures, fmt.Sprintf("event %d: %s", event.Details().EventID, err.Error()))
+		}
+	}
+
+	if len(failures) > 0 {
+		http.Error(w, strings.Join(failures, "; "), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Verify checks the signature of a webhook request with the given body. The v3 signature is used when the request
+// has one, otherwise the v1 or v2 signature.
+func (h *Handler) Verify(r *http.Request, body []byte) error {
+	if signature := r.Header.Get(signatureV3Header); signature != "" {
+		return h.verifyV3(r, body, signature)
+	}
+
+	signature := r.Header.Get(signatureHeader)
+	if signature == "" {
+		return fmt.Errorf("missing signature")
+	}
+
+	var source string
+	switch version := r.Header.Get(signatureVersionHeader); version {
+	case "v1", "":
+		source = h.ClientSecret + string(body)
+	case "v2":
+		source = h.ClientSecret + r.Method + h.requestURI(r) + string(body)
+	default:
+		return fmt.Errorf("unsupported signature version %s", version)
+	}
+
+	sum := sha256.Sum256([]byte(source))
+	expected := hex.EncodeToString(sum[:])
+
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("invalid signature")
+	}
+
+	return nil
+}
+
+// verifyV3 checks a v3 signature, which also covers the time the request was sent.
+func (h *Handler) verifyV3(r *http.Request, body []byte, signature string) error {
+	timestamp := r.Header.Get(timestampHeader)
+
+	millis, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid request timestamp")
+	}
+
+	sent := time.Unix(0, millis*int64(time.Millisecond))
+	age := h.now().Sub(sent)
+	if age > h.Tolerance || age < -h.Tolerance {
+		return fmt.Errorf("request timestamp is outside the tolerance")
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.ClientSecret))
+	mac.Write([]byte(r.Method + h.requestURI(r) + string(body) + timestamp))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("invalid signature")
+	}
+
+	return nil
+}
+
+// requestURI returns the full URL HubSpot sent the request to.
+func (h *Handler) requestURI(r *http.Request) string {
+	if h.URL != "" {
+		if r.URL.RawQuery != "" {
+			return h.URL + "?" + r.URL.RawQuery
+		}
+		return h.URL
+	}
+
+	scheme := "https"
+	if r.TLS == nil && r.Header.Get("X-Forwarded-Proto") == "http" {
+		scheme = "http"
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, r.Host, r.URL.RequestURI())
+}
@@ -0,0 +1,159 @@
+// Package webhooks covers the Webhooks API. It contains an http.Handler that receives the webhook requests HubSpot
+// sends when objects in a portal change, verifies their signature and hands every event to a callback, and a
+// service to manage the webhook settings and subscriptions of an app.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	secret = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+	body   = `[{"eventId":1,"subscriptionId":2,"portalId":3,"occurredAt":1557964800000,"subscriptionType":"contact.propertyChange","attemptNumber":0,"objectId":123,"changeSource":"CRM","propertyName":"email","propertyValue":"codey@example.com"},{"eventId":2,"subscriptionType":"deal.creation","objectId":456}]`
+)
+
+func TestHandlerV1(t *testing.T) {
+	received := make([]Event, 0)
+	h := NewHandler(secret).
+		On(ContactPropertyChange, func(e Event) error {
+			received = append(received, e)
+			return nil
+		})
+
+	sum := sha256.Sum256([]byte(secret + body))
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/webhooks", strings.NewReader(body))
+	req.Header.Set(signatureHeader, hex.EncodeToString(sum[:]))
+	req.Header.Set(signatureVersionHeader, "v1")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Len(t, received, 1)
+	assert.Equal(t, "contact", received[0].Details().ObjectType())
+	assert.Equal(t, "propertyChange", received[0].Details().Action())
+	if change, ok := received[0].(*PropertyChangeEvent); assert.True(t, ok) {
+		assert.Equal(t, "codey@example.com", change.PropertyValue)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "https://example.com/webhooks", strings.NewReader(body))
+	req.Header.Set(signatureHeader, "invalid")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandlerV3(t *testing.T) {
+	now := time.Date(2019, 5, 16, 12, 0, 0, 0, time.UTC)
+	timestamp := strconv.FormatInt(now.UnixNano()/int64(time.Millisecond), 10)
+
+	count := 0
+	h := NewHandler(secret).WithURL("https://hooks.example.com/hubspot").OnAny(func(e Event) error {
+		count++
+		return nil
+	})
+	h.now = func() time.Time { return now }
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(http.MethodPost + "https://hooks.example.com/hubspot" + body + timestamp))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	req := httptest.NewRequest(http.MethodPost, "http://internal:8080/hubspot", strings.NewReader(body))
+	req.Header.Set(signatureV3Header, signature)
+	req.Header.Set(timestampHeader, timestamp)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, 2, count)
+
+	h.now = func() time.Time { return now.Add(10 * time.Minute) }
+	req = httptest.NewRequest(http.MethodPost, "http://internal:8080/hubspot", strings.NewReader(body))
+	req.Header.Set(signatureV3Header, signature)
+	req.Header.Set(timestampHeader, timestamp)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	h.now = func() time.Time { return now.Add(-10 * time.Minute) }
+	req = httptest.NewRequest(http.MethodPost, "http://internal:8080/hubspot", strings.NewReader(body))
+	req.Header.Set(signatureV3Header, signature)
+	req.Header.Set(timestampHeader, timestamp)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandlerBatch(t *testing.T) {
+	handled := make([]int64, 0)
+	h := NewHandler(secret).OnAny(func(e Event) error {
+		handled = append(handled, e.Details().EventID)
+		if e.Details().EventID == 1 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	sum := sha256.Sum256([]byte(secret + body))
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/webhooks", strings.NewReader(body))
+	req.Header.Set(signatureHeader, hex.EncodeToString(sum[:]))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Contains(t, rec.Body.String(), "event 1: boom")
+	assert.Equal(t, []int64{1, 2}, handled)
+
+	h = h.WithMaxBodySize(10)
+	req = httptest.NewRequest(http.MethodPost, "https://example.com/webhooks", strings.NewReader(body))
+	req.Header.Set(signatureHeader, hex.EncodeToString(sum[:]))
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Len(t, handled, 2)
+}
+
+func TestUnmarshalEvents(t *testing.T) {
+	data := []byte(`[
+		{"eventId":1,"subscriptionType":"contact.merge","objectId":3,"mergedObjectIds":[1,2],"primaryObjectId":3},
+		{"eventId":2,"subscriptionType":"deal.associationChange","associationType":"DEAL_TO_CONTACT","fromObjectId":4,"toObjectId":5,"associationRemoved":true},
+		{"eventId":3,"subscriptionType":"contact.privacyDeletion","objectId":6},
+		{"eventId":4,"subscriptionType":"conversation.newMessage","objectId":7,"messageId":"m1","messageType":"MESSAGE"},
+		{"eventId":5,"subscriptionType":"contact.restore","objectId":8}
+	]`)
+
+	events, err := unmarshalEvents(data)
+	assert.NoError(t, err)
+	assert.Len(t, events, 5)
+
+	if merge, ok := events[0].(*MergeEvent); assert.True(t, ok) {
+		assert.Equal(t, []int64{1, 2}, merge.MergedObjectIDs)
+		assert.Equal(t, int64(3), merge.PrimaryObjectID)
+	}
+	if change, ok := events[1].(*AssociationChangeEvent); assert.True(t, ok) {
+		assert.Equal(t, "DEAL_TO_CONTACT", change.AssociationType)
+		assert.True(t, change.AssociationRemoved)
+	}
+	assert.IsType(t, &DeletionEvent{}, events[2])
+	if message, ok := events[3].(*NewMessageEvent); assert.True(t, ok) {
+		assert.Equal(t, "m1", message.MessageID)
+	}
+	if raw, ok := events[4].(*RawEvent); assert.True(t, ok) {
+		assert.Equal(t, "contact.restore", raw.EventType())
+		assert.Equal(t, int64(8), raw.Details().ObjectID)
+	}
+}
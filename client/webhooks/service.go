@@ -0,0 +1,152 @@
+// Package webhooks covers the Webhooks API. It contains an http.Handler that receives the webhook requests HubSpot
+// sends when objects in a portal change, verifies their signature and hands every event to a callback, and a
+// service to manage the webhook settings and subscriptions of an app.
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/retgits/hubspot/client"
+)
+
+const (
+	// settingsEndpoint is the endpoint to get, update and clear the webhook settings of an app
+	settingsEndpoint = "webhooks/v3/%d/settings"
+	// subscriptionsEndpoint is the endpoint to list and create subscriptions
+	subscriptionsEndpoint = "webhooks/v3/%d/subscriptions"
+	// subscriptionEndpoint is the endpoint to get, update and delete a single subscription
+	subscriptionEndpoint = "webhooks/v3/%d/subscriptions/%s"
+)
+
+// Webhooks contains the elements to communicate with the HubSpot Webhooks endpoints for one app. These endpoints
+// use the developer API key of the client, see client.WithDeveloperKey.
+type Webhooks struct {
+	*client.Client
+	AppID int64
+}
+
+// New creates a new instance of the Webhooks service for the given app.
+func New(c *client.Client, appID int64) *Webhooks {
+	return &Webhooks{
+		c, appID,
+	}
+}
+
+// GetSettings gets the webhook settings of the app.
+func (w *Webhooks) GetSettings() (Settings, error) {
+	url := buildURL(w, fmt.Sprintf(settingsEndpoint, w.AppID))
+
+	res, err := w.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	return unmarshalSettings(res)
+}
+
+// UpdateSettings sets the target URL and throttling of the app's webhooks.
+func (w *Webhooks) UpdateSettings(settings Settings) (Settings, error) {
+	url := buildURL(w, fmt.Sprintf(settingsEndpoint, w.AppID))
+
+	payload, err := json.Marshal(settings)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	res, err := w.Call(url, http.MethodPut, payload)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	return unmarshalSettings(res)
+}
+
+// ClearSettings removes the webhook settings of the app, which stops all webhook requests.
+func (w *Webhooks) ClearSettings() error {
+	url := buildURL(w, fmt.Sprintf(settingsEndpoint, w.AppID))
+
+	_, err := w.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// GetAllSubscriptions gets all subscriptions of the app.
+func (w *Webhooks) GetAllSubscriptions() ([]Subscription, error) {
+	url := buildURL(w, fmt.Sprintf(subscriptionsEndpoint, w.AppID))
+
+	res, err := w.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotSubscriptions(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Results, nil
+}
+
+// GetSubscription gets a single subscription by its ID.
+func (w *Webhooks) GetSubscription(subscriptionID string) (Subscription, error) {
+	url := buildURL(w, fmt.Sprintf(subscriptionEndpoint, w.AppID, subscriptionID))
+
+	res, err := w.Call(url, http.MethodGet, nil)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	return unmarshalSubscription(res)
+}
+
+// CreateSubscription subscribes the app to events of a subscription type, like contact.creation, or to changes of
+// a single property, like deal.propertyChange for dealstage.
+func (w *Webhooks) CreateSubscription(subscription Subscription) (Subscription, error) {
+	url := buildURL(w, fmt.Sprintf(subscriptionsEndpoint, w.AppID))
+
+	payload, err := json.Marshal(subscription)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	res, err := w.Call(url, http.MethodPost, payload)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	return unmarshalSubscription(res)
+}
+
+// UpdateSubscription activates or pauses a subscription.
+func (w *Webhooks) UpdateSubscription(subscriptionID string, active bool) (Subscription, error) {
+	url := buildURL(w, fmt.Sprintf(subscriptionEndpoint, w.AppID, subscriptionID))
+
+	payload, err := json.Marshal(SubscriptionUpdate{Active: active})
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	res, err := w.Call(url, http.MethodPatch, payload)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	return unmarshalSubscription(res)
+}
+
+// DeleteSubscription deletes a subscription.
+func (w *Webhooks) DeleteSubscription(subscriptionID string) error {
+	url := buildURL(w, fmt.Sprintf(subscriptionEndpoint, w.AppID, subscriptionID))
+
+	_, err := w.Call(url, http.MethodDelete, nil)
+	return err
+}
+
+// Construct the proper URL to call
+func buildURL(w *Webhooks, u string) string {
+	url := ""
+	url = fmt.Sprintf("%s?hapikey=%s", u, w.DeveloperAPIKey())
+
+	return url
+}
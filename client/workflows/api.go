@@ -0,0 +1,63 @@
+// Package workflows covers the Workflows API, which is used to read the workflows of a portal and to enroll
+// contacts in them or remove contacts from them.
+package workflows
+
+import "encoding/json"
+
+// HubSpotWorkflows is the payload returned after listing workflows
+type HubSpotWorkflows struct {
+	Workflows []Workflow `json:"workflows"`
+}
+
+// Workflow is a struct generated from the HubSpot API
+type Workflow struct {
+	ID             int64          `json:"id"`
+	Name           string         `json:"name"`
+	Type           string         `json:"type"`
+	Description    string         `json:"description,omitempty"`
+	Enabled        bool           `json:"enabled"`
+	PortalID       int64          `json:"portalId,omitempty"`
+	InsertedAt     int64          `json:"insertedAt"`
+	UpdatedAt      int64          `json:"updatedAt"`
+	PersonaTagIDS  []int64        `json:"personaTagIds"`
+	ContactListIDS ContactListIDS `json:"contactListIds"`
+	Actions        []interface{}  `json:"actions,omitempty"`
+}
+
+// ContactListIDS are the IDs of the lists HubSpot uses to track the contacts in a workflow
+type ContactListIDS struct {
+	Enrolled  int64 `json:"enrolled"`
+	Active    int64 `json:"active"`
+	Completed int64 `json:"completed"`
+	Succeeded int64 `json:"succeeded"`
+}
+
+// Enrollment is a workflow a contact is currently enrolled in
+type Enrollment struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Enabled     bool   `json:"enabled"`
+	PortalID    int64  `json:"portalId"`
+	InsertedAt  int64  `json:"insertedAt"`
+	UpdatedAt   int64  `json:"updatedAt"`
+	Description string `json:"description,omitempty"`
+}
+
+func unmarshalHubSpotWorkflows(data []byte) (HubSpotWorkflows, error) {
+	var r HubSpotWorkflows
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalWorkflow(data []byte) (Workflow, error) {
+	var r Workflow
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
+
+func unmarshalEnrollments(data []byte) ([]Enrollment, error) {
+	var r []Enrollment
+	err := json.Unmarshal(data, &r)
+	return r, err
+}
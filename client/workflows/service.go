@@ -0,0 +1,126 @@
+// Package workflows covers the Workflows API, which is used to read the workflows of a portal and to enroll
+// contacts in them or remove contacts from them.
+package workflows
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/retgits/hubspot/client"
+	"github.com/retgits/hubspot/client/contacts"
+)
+
+const (
+	// workflowsEndpoint is the endpoint to list workflows
+	workflowsEndpoint = "automation/v3/workflows"
+	// workflowEndpoint is the endpoint to get a single workflow
+	workflowEndpoint = "automation/v3/workflows/%d"
+	// enrollmentEndpoint is the endpoint to enroll a contact in a workflow, or remove it from the workflow
+	enrollmentEndpoint = "automation/v2/workflows/%d/enrollments/contacts/%s"
+	// enrollmentsEndpoint is the endpoint to get the workflows a contact is enrolled in
+	enrollmentsEndpoint = "automation/v2/workflows/enrollments/contacts/%d"
+)
+
+// Workflows contains the elements to communicate with the HubSpot Workflows endpoints.
+type Workflows struct {
+	*client.Client
+}
+
+// New creates a new instance of the Workflows service with default settings.
+func New(c *client.Client) *Workflows {
+	return &Workflows{
+		c,
+	}
+}
+
+// GetAllWorkflows gets all workflows of the portal. The actions of the workflows aren't returned.
+func (w *Workflows) GetAllWorkflows() ([]Workflow, error) {
+	u := buildURL(w, workflowsEndpoint)
+
+	res, err := w.Call(u, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	temp, err := unmarshalHubSpotWorkflows(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return temp.Workflows, nil
+}
+
+// GetWorkflow gets a single workflow by its ID.
+func (w *Workflows) GetWorkflow(workflowID int64) (Workflow, error) {
+	u := buildURL(w, fmt.Sprintf(workflowEndpoint, workflowID))
+
+	res, err := w.Call(u, http.MethodGet, nil)
+	if err != nil {
+		return Workflow{}, err
+	}
+
+	return unmarshalWorkflow(res)
+}
+
+// EnrollEmail enrolls the contact with the given email address in a workflow. The contact is created when it
+// doesn't exist yet.
+func (w *Workflows) EnrollEmail(workflowID int64, email string) error {
+	endpoint := fmt.Sprintf(enrollmentEndpoint, workflowID, url.PathEscape(email))
+
+	_, err := w.Call(buildURL(w, endpoint), http.MethodPost, nil)
+	return err
+}
+
+// UnenrollEmail removes the contact with the given email address from a workflow.
+func (w *Workflows) UnenrollEmail(workflowID int64, email string) error {
+	endpoint := fmt.Sprintf(enrollmentEndpoint, workflowID, url.PathEscape(email))
+
+	_, err := w.Call(buildURL(w, endpoint), http.MethodDelete, nil)
+	return err
+}
+
+// EnrollContact enrolls a contact in a workflow, using the primary email address of the contact.
+func (w *Workflows) EnrollContact(workflowID int64, contact contacts.Contact) error {
+	email := contact.Email()
+	if email == "" {
+		return fmt.Errorf("contact %d has no email address", contact.Vid)
+	}
+
+	return w.EnrollEmail(workflowID, email)
+}
+
+// UnenrollContact removes a contact from a workflow, using the primary email address of the contact.
+func (w *Workflows) UnenrollContact(workflowID int64, contact contacts.Contact) error {
+	email := contact.Email()
+	if email == "" {
+		return fmt.Errorf("contact %d has no email address", contact.Vid)
+	}
+
+	return w.UnenrollEmail(workflowID, email)
+}
+
+// GetEnrollments gets the workflows a contact is currently enrolled in.
+func (w *Workflows) GetEnrollments(contact contacts.Contact) ([]Enrollment, error) {
+	return w.GetEnrollmentsByVid(contact.Vid)
+}
+
+// GetEnrollmentsByVid gets the workflows the contact with the given vid is currently enrolled in.
+func (w *Workflows) GetEnrollmentsByVid(vid int64) ([]Enrollment, error) {
+	u := buildURL(w, fmt.Sprintf(enrollmentsEndpoint, vid))
+
+	res, err := w.Call(u, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalEnrollments(res)
+}
+
+// Construct the proper URL to call
+func buildURL(w *Workflows, endpoint string) string {
+	u := ""
+	u = fmt.Sprintf("%s?hapikey=%s", endpoint, w.APIKey)
+
+	return u
+}